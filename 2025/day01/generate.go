@@ -0,0 +1,20 @@
+package day01
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+)
+
+// generate writes size rotations of 1 to 999 clicks in either direction.
+func generate(r *rand.Rand, size int) []byte {
+	var b bytes.Buffer
+	for range size {
+		dir := 'L'
+		if r.IntN(2) == 1 {
+			dir = 'R'
+		}
+		fmt.Fprintf(&b, "%c%d\n", dir, 1+r.IntN(999))
+	}
+	return b.Bytes()
+}
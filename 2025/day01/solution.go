@@ -0,0 +1,118 @@
+package day01
+
+import (
+	"adventofcode/aoc"
+	"adventofcode/aoc/grammar"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     2025,
+		Day:      1,
+		Part1:    aoc.With(aoc.Lines, solvePart1),
+		Part2:    aoc.With(aoc.Lines, solvePart2),
+		Validate: inputGrammar.Check,
+		Generate: generate,
+	})
+}
+
+// inputGrammar is one rotation per line, e.g. "L68".
+var inputGrammar = grammar.Lines(grammar.Each(grammar.Seq(grammar.OneOf("LR"), grammar.Uint())))
+
+// solvePart1 contains the logic for the first part of the puzzle.
+func solvePart1(lines []string) (int, error) {
+	init := 50
+	total := 0
+	for i, line := range lines {
+		if len(line) == 0 {
+			return total, nil
+		}
+		charSlice := []rune(line)
+		if prefix := charSlice[0]; prefix == 'L' {
+			intVal, err := aoc.Token{Text: line[1:], Col: 2}.Atoi(i + 1)
+			if err != nil {
+				return 0, err
+			}
+			init -= intVal
+		} else if prefix == 'R' {
+			intVal, err := aoc.Token{Text: line[1:], Col: 2}.Atoi(i + 1)
+			if err != nil {
+				return 0, err
+			}
+			init += intVal
+		} else {
+			return 0, aoc.Errorf(i+1, 1, "expected L or R, got %q", prefix)
+		}
+		for {
+			if init < 0 {
+				init += 100
+			} else if init > 99 {
+				init -= 100
+			} else {
+				if init == 0 {
+					total += 1
+				}
+				break
+			}
+		}
+	}
+	return total, nil
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+func solvePart2(lines []string) (int, error) {
+	init := 50
+	total := 0
+	// one condition should not be considered to plus one,
+	// currently dial is at 0 and turned left.
+	temp := 0
+	for i, line := range lines {
+		if len(line) == 0 {
+			return total, nil
+		}
+		charSlice := []rune(line)
+		if prefix := charSlice[0]; prefix == 'L' {
+			intVal, err := aoc.Token{Text: line[1:], Col: 2}.Atoi(i + 1)
+			if err != nil {
+				return 0, err
+			}
+			if intVal == 0 {
+				// the dial does not move, so it does not point at 0 again.
+				continue
+			}
+			if init == 0 {
+				temp = -1
+			}
+			init -= intVal
+		} else if prefix == 'R' {
+			intVal, err := aoc.Token{Text: line[1:], Col: 2}.Atoi(i + 1)
+			if err != nil {
+				return 0, err
+			}
+			if intVal == 0 {
+				continue
+			}
+			init += intVal
+		} else {
+			return 0, aoc.Errorf(i+1, 1, "expected L or R, got %q", prefix)
+		}
+		quotient := init / 100
+		remainder := init % 100
+
+		if init < 0 {
+			init = (100 + remainder) % 100
+			total += 1 - quotient
+			if temp != 0 {
+				total += temp
+				temp = 0
+			}
+		} else if init > 99 {
+			init = remainder
+			total += quotient
+		} else if init == 0 {
+			total += 1
+		}
+	}
+	return total, nil
+}
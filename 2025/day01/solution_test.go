@@ -0,0 +1,81 @@
+package day01
+
+import (
+	"fmt"
+	"testing"
+
+	"adventofcode/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 1, []aoctest.Example{
+		{Input: "input2.txt", Part: 1},
+		{Input: "input2.txt", Part: 2},
+	})
+}
+
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, 2025, 1, 1, 2000)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, 2025, 1, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, 2025, 1, 2, "input.txt")
+}
+
+// rotations decodes fuzz data into input lines, two bytes per rotation: the
+// low bit of the first byte picks the direction and the rest of the two
+// bytes give a distance of 0 to 1023.
+func rotations(data []byte) []string {
+	var lines []string
+	for i := 0; i+1 < len(data); i += 2 {
+		dir := "L"
+		if data[i]&1 == 1 {
+			dir = "R"
+		}
+		lines = append(lines, fmt.Sprintf("%s%d", dir, int(data[i]>>1&3)<<8|int(data[i+1])))
+	}
+	return lines
+}
+
+// clicks is the brute-force reference for both parts: it turns the dial one
+// click at a time and counts how often it stops at 0 after a rotation and
+// how often it points at 0 at all.
+func clicks(lines []string) (stops, passes int) {
+	dial := 50
+	for _, line := range lines {
+		var n int
+		fmt.Sscanf(line[1:], "%d", &n)
+		step := 1
+		if line[0] == 'L' {
+			step = 99
+		}
+		for range n {
+			dial = (dial + step) % 100
+			if dial == 0 {
+				passes++
+			}
+		}
+		if dial == 0 {
+			stops++
+		}
+	}
+	return stops, passes
+}
+
+func FuzzSolve(f *testing.F) {
+	f.Add([]byte{0, 68, 0, 30, 1, 48, 0, 5, 1, 60, 0, 55, 0, 1, 0, 99, 1, 14, 0, 82})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		lines := rotations(data)
+		stops, passes := clicks(lines)
+		if got, err := solvePart1(lines); err != nil || got != stops {
+			t.Errorf("solvePart1(%q) = %d, %v, want %d", lines, got, err, stops)
+		}
+		if got, err := solvePart2(lines); err != nil || got != passes {
+			t.Errorf("solvePart2(%q) = %d, %v, want %d", lines, got, err, passes)
+		}
+	})
+}
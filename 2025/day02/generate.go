@@ -0,0 +1,28 @@
+package day02
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+)
+
+// generate writes a single line of size comma separated ID ranges. Each range
+// starts at an ID of 1 to 10 digits and is at most a tenth of that long, so
+// its end has at most one digit more, as solvePart2 requires.
+func generate(r *rand.Rand, size int) []byte {
+	var b bytes.Buffer
+	for i := range size {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		digits := 1 + r.IntN(10)
+		lo := int64(1)
+		for range digits - 1 {
+			lo *= 10
+		}
+		start := lo + r.Int64N(9*lo)
+		fmt.Fprintf(&b, "%d-%d", start, start+r.Int64N(lo))
+	}
+	b.WriteByte('\n')
+	return b.Bytes()
+}
@@ -0,0 +1,271 @@
+package day02
+
+import (
+	"fmt"
+	"strconv"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/checked"
+	"adventofcode/aoc/grammar"
+	"adventofcode/aoc/trace"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     2025,
+		Day:      2,
+		Part1:    aoc.With(aoc.Lines, solvePart1),
+		Part2:    aoc.With(aoc.Lines, solvePart2),
+		Validate: inputGrammar.Check,
+		Generate: generate,
+	})
+}
+
+// inputGrammar is a comma separated list of "start-end" ID ranges.
+var inputGrammar = grammar.Lines(grammar.Each(grammar.Seq(
+	grammar.List(grammar.Seq(grammar.Uint(), grammar.Lit("-"), grammar.Uint()), grammar.Lit(",")),
+	grammar.Opt(grammar.Lit(",")),
+)))
+
+var tr = trace.New("day02")
+
+// pair is one "start-end" range of the input together with its position.
+type pair struct {
+	aoc.Token
+	line int
+}
+
+// readPairs returns the comma separated ranges of every line.
+func readPairs(lines []string) []pair {
+	var pairs []pair
+	for i, line := range lines {
+		for _, tok := range aoc.Split(line, ",") {
+			if tok = tok.TrimSpace(); tok.Text != "" {
+				pairs = append(pairs, pair{tok, i + 1})
+			}
+		}
+	}
+	return pairs
+}
+
+// bounds parses the start and end of p, also returning the two tokens.
+func (p pair) bounds() ([]aoc.Token, int64, int64, error) {
+	parts := p.Split("-")
+	if len(parts) != 2 {
+		return nil, 0, 0, p.Errorf(p.line, "expected start-end, got %q", p.Text)
+	}
+	start, err := parts[0].ParseInt(p.line)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	end, err := parts[1].ParseInt(p.line)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return parts, start, end, nil
+}
+
+// solvePart1 contains the logic for the first part of the puzzle.
+func solvePart1(lines []string) (int64, error) {
+	var total int64 = 0
+	for _, pair := range readPairs(lines) {
+		_, start, end, err := pair.bounds()
+		if err != nil {
+			return 0, err
+		}
+		i := start
+		for i <= end {
+			s := strconv.FormatInt(i, 10)
+			idRunes := []rune(s)
+			lenRunes := len(idRunes)
+			if lenRunes % 2 != 0 {
+				// for all i in start to lenRunes of 9s, there is no what 
+				// we are looking for.
+				if i, err = checked.Pow(int64(10), lenRunes); err != nil {
+					// no int64 has more digits, so none is left in range
+					break
+				}
+				continue
+			}
+
+			// only for length of i is even, may have what is asked for
+			firstHalfRunes := idRunes[:lenRunes / 2]
+			firstHalfStr := string(firstHalfRunes)
+			firstHalfInt, err := strconv.ParseInt(firstHalfStr, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("converting first half of %s: %w", s, err)
+			}
+			pssblInvalidId, err := fillin(firstHalfInt, int64(lenRunes), lenRunes / 2)
+			if err != nil {
+				return 0, err
+			}
+			if pssblInvalidId < i {
+				firstHalfInt += 1
+				if i, err = fillin(firstHalfInt, int64(lenRunes), lenRunes / 2); err != nil {
+					return 0, err
+				}
+			} else if pssblInvalidId <= end {
+				// in the scope, adds up and go on
+				if total, err = checked.Add(total, pssblInvalidId); err != nil {
+					return 0, err
+				}
+				firstHalfInt += 1
+				if i, err = fillin(firstHalfInt, int64(lenRunes), lenRunes / 2); err != nil {
+					return 0, err
+				}
+			} else {
+				break
+			}
+		}
+	}
+	return total, nil
+}
+
+// fillin repeats itr, a number of divisor digits, to lens digits, e.g.
+// fillin(12, 6, 2) = 121212. It reports an overflow if that is too large
+// for an int64.
+func fillin(itr int64, lens int64, divisor int) (int64, error) {
+	sum := itr
+	shift, err := checked.Pow(int64(10), divisor)
+	if err != nil {
+		return 0, err
+	}
+
+	quotient := lens / int64(divisor)
+	for j := int64(0); j < quotient - 1; j++ {
+		if sum, err = checked.Mul(sum, shift); err != nil {
+			return 0, err
+		}
+		if sum, err = checked.Add(sum, itr); err != nil {
+			return 0, err
+		}
+	}
+
+	return sum, nil
+}
+
+func solvePairs(start int64, end int64) (int64, error) {
+	var total int64 = 0
+	primeNumArr := []int{2, 3, 5, 7, 11, 13, 17, 19}
+	primeNum := make(map[int]bool)
+
+	for _, number := range primeNumArr {
+		primeNum[number] = true
+	}
+	cmpstNum := map[int][]int {
+		4: []int{2},
+		6: []int{2, 3},
+		8: []int{2, 4},
+		9: []int{3},
+		10: []int{2, 5},
+		12: []int{2, 3, 4, 6},
+		14: []int{2, 7},
+		15: []int{3, 5},
+		16: []int{2, 4, 8},
+		18: []int{2, 3, 6, 9},
+	}
+	
+	// tidy up input
+	s := strconv.FormatInt(start, 10)
+	idRunes := []rune(s)
+	lenRunes := len(idRunes)
+	// _, existsInPrimeNum := primeNum[lenRunes]
+	divisors := []int{}
+
+	if lenRunes == 1 {
+		// single digit always cannot be invalid ID.
+		return 0, nil
+	} else if len(cmpstNum[lenRunes]) > 0 {
+		// divisors := cmpstNum[lenRunes]
+		divisors = append(divisors, cmpstNum[lenRunes]...)
+	}
+	divisors = append(divisors, 1)
+	tr.Debugf("start: %d, end: %d, lenRunes: %d, divisors: %v", start, end, lenRunes, divisors)
+	pssblInvalidIdMap := make(map[int64]bool)
+	for _, divisor := range divisors {
+		firstDivisorStr := string(idRunes[:divisor])
+		tr.Verbosef("firstDivisorStr: %s", firstDivisorStr)
+		firstDivisorInt, err := strconv.ParseInt(firstDivisorStr, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("converting %s: %w", firstDivisorStr, err)
+		}
+
+		// An ID too large for an int64 is past end, so an overflow in
+		// fillin ends the search like any other ID beyond end.
+		i := start
+		for i <= end {
+			pssblInvalidId, err := fillin(firstDivisorInt, int64(lenRunes), divisor)
+			if err != nil {
+				break
+			}
+			if pssblInvalidId < start {
+				firstDivisorInt += 1
+				if i, err = fillin(firstDivisorInt, int64(lenRunes), divisor); err != nil {
+					break
+				}
+			} else if pssblInvalidId <= end {
+				// in the scope
+				pssblInvalidIdMap[pssblInvalidId] = true
+				firstDivisorInt += 1
+				if i, err = fillin(firstDivisorInt, int64(lenRunes), divisor); err != nil {
+					break
+				}
+			} else {
+				break
+			}
+			if len(strconv.FormatInt(firstDivisorInt, 10)) > len(firstDivisorStr) {
+				// firstDivisorInt overflow
+				break
+			}
+		}
+	
+	}
+	for ptntlInvalidId, _ := range pssblInvalidIdMap {
+		var err error
+		if total, err = checked.Add(total, ptntlInvalidId); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+func solvePart2(lines []string) (int64, error) {
+	var total int64 = 0
+	for _, pair := range readPairs(lines) {
+		parts, start, end, err := pair.bounds()
+		if err != nil {
+			return 0, err
+		}
+
+		// compare length of start and end, if not same, divide 
+		var sum int64
+		if  len(parts[1].Text) - len(parts[0].Text) == 0 {
+			sum, err = solvePairs(start, end)
+		} else if len(parts[1].Text) - len(parts[0].Text) == 1 {
+			// get end length 
+			var middle, upper int64
+			middle, err = checked.Pow(int64(10), len(parts[0].Text))
+			if err == nil {
+				sum, err = solvePairs(start, middle - 1)
+			}
+			if err == nil {
+				upper, err = solvePairs(middle, end)
+			}
+			if err == nil {
+				sum, err = checked.Add(sum, upper)
+			}
+		} else {
+			// not happen according to current data
+			return 0, pair.Errorf(pair.line, "cannot split %q into ranges of a single ID length", pair.Text)
+		}
+		if err != nil {
+			return 0, err
+		}
+		if total, err = checked.Add(total, sum); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
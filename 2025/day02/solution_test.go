@@ -0,0 +1,139 @@
+package day02
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"adventofcode/aoc/aoctest"
+	"adventofcode/aoc/checked"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 2, []aoctest.Example{
+		{Input: "input2.txt", Part: 1},
+		{Input: "input2.txt", Part: 2},
+	})
+}
+
+func TestFillin(t *testing.T) {
+	tests := []struct {
+		itr     int64
+		lens    int64
+		divisor int
+		want    int64
+	}{
+		{1, 2, 1, 11},
+		{1, 3, 1, 111},
+		{12, 6, 2, 121212},
+		{123, 6, 3, 123123},
+		{1188511, 14, 7, 11885111188511},
+	}
+
+	for _, tt := range tests {
+		if got, err := fillin(tt.itr, tt.lens, tt.divisor); err != nil || got != tt.want {
+			t.Errorf("fillin(%d, %d, %d) = %d, %v, want %d", tt.itr, tt.lens, tt.divisor, got, err, tt.want)
+		}
+	}
+	if got, err := fillin(9, 19, 1); !errors.Is(err, checked.ErrOverflow) {
+		t.Errorf("fillin(9, 19, 1) = %d, %v, want an overflow", got, err)
+	}
+}
+
+// TestNineteenDigits covers IDs near the top of the int64 range, where
+// candidate IDs stop fitting and sums overflow.
+func TestNineteenDigits(t *testing.T) {
+	tests := []struct {
+		line         string
+		part1, part2 int64
+		overflow     bool
+	}{
+		{"9000000000000000000-9223372036854775807", 0, 0, false},
+		{"1111111111111111111-2222222222222222222", 0, 3333333333333333333, false},
+		{"1000000000000000000-9223372036854775807", 0, 0, true},
+	}
+	for _, tt := range tests {
+		if got, err := solvePart1([]string{tt.line}); err != nil || got != tt.part1 {
+			t.Errorf("part 1 of %s = %d, %v, want %d", tt.line, got, err, tt.part1)
+		}
+		got, err := solvePart2([]string{tt.line})
+		if tt.overflow {
+			if !errors.Is(err, checked.ErrOverflow) {
+				t.Errorf("part 2 of %s = %d, %v, want an overflow", tt.line, got, err)
+			}
+		} else if err != nil || got != tt.part2 {
+			t.Errorf("part 2 of %s = %d, %v, want %d", tt.line, got, err, tt.part2)
+		}
+	}
+}
+
+// repeated is the brute-force reference: it reports whether id is a
+// sequence of digits repeated exactly twice, and whether it is one repeated
+// at least twice.
+func repeated(id int64) (twice, many bool) {
+	s := strconv.FormatInt(id, 10)
+	for n := 1; n <= len(s)/2; n++ {
+		if len(s)%n == 0 && strings.Repeat(s[:n], len(s)/n) == s {
+			many = true
+			if len(s) == 2*n {
+				twice = true
+			}
+		}
+	}
+	return twice, many
+}
+
+// idRange turns fuzz values into a range of at most 1<<16 IDs that all have
+// the same number of digits, from 1 to 18.
+func idRange(digits uint8, start uint64, span uint16) (int64, int64) {
+	lo := int64(1)
+	for range digits % 18 {
+		lo *= 10
+	}
+	hi := lo*10 - 1
+	first := lo + int64(start%uint64(hi-lo+1))
+	return first, min(hi, first+int64(span))
+}
+
+func FuzzSolve(f *testing.F) {
+	f.Add(uint8(1), uint64(0), uint16(11))
+	f.Add(uint8(5), uint64(122220), uint16(4))
+	f.Add(uint8(9), uint64(188511880), uint16(10))
+	f.Add(uint8(17), uint64(0), uint16(65535))
+	f.Add(uint8(16), uint64(89999999999999990), uint16(100))
+	f.Add(uint8(17), uint64(899999999999999990), uint16(100))
+	f.Fuzz(func(t *testing.T, digits uint8, start uint64, span uint16) {
+		first, last := idRange(digits, start, span)
+		var twice, many int64
+		for id := first; id <= last; id++ {
+			tw, m := repeated(id)
+			if tw {
+				twice += id
+			}
+			if m {
+				many += id
+			}
+		}
+		line := fmt.Sprintf("%d-%d", first, last)
+		if got, err := solvePart1([]string{line}); err != nil || got != twice {
+			t.Errorf("solvePart1(%q) = %d, %v, want %d", line, got, err, twice)
+		}
+		if got, err := solvePairs(first, last); err != nil || got != many {
+			t.Errorf("solvePairs(%d, %d) = %d, %v, want %d", first, last, got, err, many)
+		}
+	})
+}
+
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, 2025, 2, 1, 50)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, 2025, 2, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, 2025, 2, 2, "input.txt")
+}
@@ -0,0 +1,17 @@
+package day03
+
+import (
+	"math/rand/v2"
+)
+
+// generate writes size banks of 12 to 100 batteries with joltages 1 to 9.
+func generate(r *rand.Rand, size int) []byte {
+	var b []byte
+	for range size {
+		for range 12 + r.IntN(89) {
+			b = append(b, byte('1'+r.IntN(9)))
+		}
+		b = append(b, '\n')
+	}
+	return b
+}
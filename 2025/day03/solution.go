@@ -0,0 +1,150 @@
+package day03
+
+import (
+	"adventofcode/aoc"
+	"adventofcode/aoc/checked"
+	"adventofcode/aoc/grammar"
+	"adventofcode/aoc/trace"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     2025,
+		Day:      3,
+		Part1:    aoc.With(aoc.Lines, solvePart1),
+		Part2:    aoc.With(aoc.Lines, solvePart2),
+		Validate: inputGrammar.Check,
+		Generate: generate,
+	})
+}
+
+// inputGrammar is one bank of battery joltage digits per line.
+var inputGrammar = grammar.Lines(grammar.Each(grammar.Digits()))
+
+var tr = trace.New("day03")
+
+// checkBank reports the first character of a bank that is not a battery
+// joltage digit, or a bank too short to turn on size batteries.
+func checkBank(line string, lineNo, size int) error {
+	for i := 0; i < len(line); i++ {
+		if line[i] < '0' || line[i] > '9' {
+			return aoc.Errorf(lineNo, i+1, "expected a digit, got %q", line[i])
+		}
+	}
+	if len(line) < size {
+		return aoc.Errorf(lineNo, 0, "bank has %d batteries, need at least %d", len(line), size)
+	}
+	return nil
+}
+
+// solvePart1 contains the logic for the first part of the puzzle.
+func solvePart1(lines []string) (int64, error) {
+	var total int64
+
+	for n, line := range lines {
+		if err := checkBank(line, n+1, 2); err != nil {
+			return 0, err
+		}
+		bigDgt := map[int]int{
+			0: 0,
+			1: 0,
+		}
+		
+		lineLen := len(line)
+
+		for i := 0; i < lineLen - 1; i++ {
+			currentDgt := int(line[i] - '0')
+			
+			if currentDgt > bigDgt[0] {
+				bigDgt[0] = currentDgt
+				bigDgt[1] = 0
+			} else if currentDgt == bigDgt[0] {
+				bigDgt[1] = currentDgt
+			} else {
+				if currentDgt >= bigDgt[1] {
+					bigDgt[1] = currentDgt
+				}
+			}
+
+		}
+		lastDgt := int(line[lineLen - 1] - '0')
+		if bigDgt[1] < lastDgt {
+			bigDgt[1] = lastDgt
+		}
+		current := int64(bigDgt[0] * 10 + bigDgt[1])
+		tr.Debugf("number of this line is %d", current)
+		var err error
+		if total, err = checked.Add(total, current); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+func solvePart2(lines []string) (int64, error) {
+	var total int64
+
+	for n, line := range lines {
+		if err := checkBank(line, n+1, 12); err != nil {
+			return 0, err
+		}
+		// current has 12 digits, more than an int may hold.
+		var current int64
+		bigDgt := map[int]int{
+			0: 0,
+			1: 0,
+			2: 0,
+			3: 0,
+			4: 0,
+			5: 0,
+			6: 0,
+			7: 0,
+			8: 0,
+			9: 0,
+			10: 0,
+			11: 0,
+		}
+		
+		lineLen := len(line)
+
+		for i:= 0; i < lineLen; i++ {
+// 5373475263753258336423442254746263332334232217334431337464342726873125223932312363675175435324343745
+
+			currentDgt := int(line[i] - '0')
+
+			for j := 0; j < len(bigDgt); j++ {
+				// passkey, for key bigger than passkey, reset correspondent value to 0
+				// 81111111111111[9]
+				// lineLen=15, i=15, resLen=2, key=0 lineLen-i=0 >= reslen-key-2=0 no
+				// lineLen=15, i=15, resLen=2, key=1 lineLen-i=0 >= reslen-key-2=-1 yes
+				// 8111111111111[9]1
+				// lineLen=15, i=14, reslen=2, key=0 lineLen-i=1 >= reslen-key-2=0 yes
+				// 8[1]1111111111144
+				// lineLen=15, i=1, reslen=2, key=1 lineLen-i=14 >= reslen-key-2=-1 yes
+				if currentDgt > bigDgt[j] && lineLen-i >= 12-j {
+					bigDgt[j] = currentDgt
+					for k := j + 1; k < len(bigDgt); k++ {
+						bigDgt[k] = 0
+					}
+					break
+				}
+			}
+			tr.Verbosef("i: %d, bigDgt: %v", i, bigDgt)
+
+		}
+
+		for i := 0; i < len(bigDgt); i++ {
+			current = current * 10 + int64(bigDgt[i])
+		}
+		tr.Debugf("number of this line is %d", current)
+		var err error
+		if total, err = checked.Add(total, current); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+
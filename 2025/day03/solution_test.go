@@ -0,0 +1,76 @@
+package day03
+
+import (
+	"math/bits"
+	"testing"
+
+	"adventofcode/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 3, []aoctest.Example{
+		{Input: "input2.txt", Part: 1},
+		{Input: "input2.txt", Part: 2},
+	})
+}
+
+// bank turns fuzz data into a bank of at most 16 batteries, short enough
+// for largest to try every choice.
+func bank(data []byte) string {
+	digits := make([]byte, 0, 16)
+	for _, b := range data[:min(len(data), 16)] {
+		digits = append(digits, '0'+(b-'0')%10)
+	}
+	return string(digits)
+}
+
+// largest is the brute-force reference: it tries every way of turning on
+// size batteries of line and returns the largest joltage.
+func largest(line string, size int) int64 {
+	var best int64
+	for set := uint(0); set < 1<<len(line); set++ {
+		if bits.OnesCount(set) != size {
+			continue
+		}
+		var joltage int64
+		for i := range len(line) {
+			if set&(1<<i) != 0 {
+				joltage = joltage*10 + int64(line[i]-'0')
+			}
+		}
+		best = max(best, joltage)
+	}
+	return best
+}
+
+func FuzzSolve(f *testing.F) {
+	f.Add([]byte("987654321111111"))
+	f.Add([]byte("811111111111119"))
+	f.Add([]byte("234234234234278"))
+	f.Add([]byte("818181911112111"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		line := bank(data)
+		for part, solve := range []func([]string) (int64, error){solvePart1, solvePart2} {
+			size := []int{2, 12}[part]
+			if len(line) < size {
+				continue
+			}
+			want := largest(line, size)
+			if got, err := solve([]string{line}); err != nil || got != want {
+				t.Errorf("part %d of %q = %d, %v, want %d", part+1, line, got, err, want)
+			}
+		}
+	})
+}
+
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, 2025, 3, 1, 300)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, 2025, 3, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, 2025, 3, 2, "input.txt")
+}
@@ -0,0 +1,22 @@
+package day04
+
+import (
+	"math/rand/v2"
+)
+
+// generate writes a size by size grid in which about two thirds of the
+// cells hold a paper roll.
+func generate(r *rand.Rand, size int) []byte {
+	var b []byte
+	for range size {
+		for range size {
+			if r.IntN(3) == 0 {
+				b = append(b, '.')
+			} else {
+				b = append(b, '@')
+			}
+		}
+		b = append(b, '\n')
+	}
+	return b
+}
@@ -0,0 +1,141 @@
+package day04
+
+import (
+	"fmt"
+	"io"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/grammar"
+	"adventofcode/aoc/grid"
+	"adventofcode/aoc/trace"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     2025,
+		Day:      4,
+		Part1:    aoc.With(aoc.Lines, solvePart1),
+		Part2:    aoc.With(aoc.Lines, solvePart2),
+		Validate: inputGrammar.Check,
+		Generate: generate,
+		Animate:  animate,
+	})
+}
+
+// inputGrammar is a rectangle of paper rolls '@' and empty floor '.'.
+var inputGrammar = grammar.Lines(grammar.SameWidth(grammar.Each(grammar.Chars("@."))))
+
+var tr = trace.New("day04")
+
+// readGrid parses the diagram into a grid of paper rolls, reporting the
+// first cell that is neither a roll '@' nor empty floor '.' and rows shorter
+// or longer than the first one.
+func readGrid(lines []string) (*grid.Grid[bool], error) {
+	return grid.Parse(lines, func(b byte) (bool, error) {
+		if b != '@' && b != '.' {
+			return false, fmt.Errorf("expected '@' or '.', got %q", b)
+		}
+		return b == '@', nil
+	})
+}
+
+// accessible reports whether the roll at p has fewer than four rolls among
+// its eight neighbours, so a forklift can reach it.
+func accessible(g *grid.Grid[bool], p grid.Point) bool {
+	rolls := 0
+	for _, roll := range g.Neighbours8(p) {
+		if roll {
+			rolls++
+		}
+	}
+	return rolls < 4
+}
+
+// solvePart1 contains the logic for the first part of the puzzle.
+func solvePart1(lines []string) (int, error) {
+	g, err := readGrid(lines)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for p, roll := range g.All() {
+		if roll && accessible(g, p) {
+			total += 1
+			tr.Verbosef("location: %v", p)
+		}
+	}
+	return total, nil
+}
+
+// removeWaves removes accessible rolls wave by wave until none is left and
+// returns how many it removed. After each wave that removed any, it calls
+// wave, when not nil, with the rolls that wave removed.
+func removeWaves(g *grid.Grid[bool], wave func(removed []grid.Point)) int {
+	total := 0
+	for {
+		// A wave takes every roll accessible at its start, as the puzzle
+		// does, so rolls are only removed once all are found.
+		var removed []grid.Point
+		for p, roll := range g.All() {
+			if roll && accessible(g, p) {
+				removed = append(removed, p)
+				tr.Verbosef("location: %v", p)
+			}
+		}
+		for _, p := range removed {
+			g.Set(p, false)
+		}
+		innerSum := len(removed)
+		tr.Debugf("removed %d rolls in this wave", innerSum)
+		if innerSum == 0 {
+			break
+		}
+		total += innerSum
+		if wave != nil {
+			wave(removed)
+		}
+	}
+	return total
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+func solvePart2(lines []string) (int, error) {
+	g, err := readGrid(lines)
+	if err != nil {
+		return 0, err
+	}
+	return removeWaves(g, nil), nil
+}
+
+// animate draws part 2: the floor, then every wave with the rolls it
+// removes marked 'x', as the puzzle does, and the floor that is left.
+func animate(r io.Reader, frame func(rows []string)) error {
+	lines, err := aoc.Lines(r)
+	if err != nil {
+		return err
+	}
+	g, err := readGrid(lines)
+	if err != nil {
+		return err
+	}
+	draw := func(list []grid.Point) []string {
+		removed := make(map[grid.Point]bool, len(list))
+		for _, p := range list {
+			removed[p] = true
+		}
+		return grid.Rows(g, func(p grid.Point, roll bool) byte {
+			switch {
+			case roll:
+				return '@'
+			case removed[p]:
+				return 'x'
+			}
+			return '.'
+		})
+	}
+	frame(draw(nil))
+	removeWaves(g, func(removed []grid.Point) { frame(draw(removed)) })
+	frame(draw(nil))
+	return nil
+}
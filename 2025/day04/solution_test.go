@@ -0,0 +1,75 @@
+package day04
+
+import (
+	"bytes"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"adventofcode/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 4, []aoctest.Example{
+		{Input: "input2.txt", Part: 1},
+		{Input: "input2.txt", Part: 2},
+	})
+}
+
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, 2025, 4, 1, 150)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, 2025, 4, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, 2025, 4, 2, "input.txt")
+}
+
+// TestAnimate checks the frames of part 2 on the example against the
+// puzzle: the floor as given, the nine waves with the rolls each removes
+// marked, and a floor left with 43 rolls fewer.
+func TestAnimate(t *testing.T) {
+	data, err := os.ReadFile("input2.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var frames [][]string
+	if err := animate(bytes.NewReader(data), func(rows []string) { frames = append(frames, rows) }); err != nil {
+		t.Fatal(err)
+	}
+	waves := []int{13, 12, 7, 5, 2, 1, 1, 1, 1}
+	if len(frames) != len(waves)+2 {
+		t.Fatalf("%d frames, want the floor, %d waves and the rest", len(frames), len(waves))
+	}
+	if want := strings.Fields(string(data)); !slices.Equal(frames[0], want) {
+		t.Errorf("first frame:\n%s\nwant the input", strings.Join(frames[0], "\n"))
+	}
+	first := []string{
+		"..xx.xx@x.",
+		"x@@.@.@.@@",
+		"@@@@@.x.@@",
+		"@.@@@@..@.",
+		"x@.@@@@.@x",
+		".@@@@@@@.@",
+		".@.@.@.@@@",
+		"x.@@@.@@@@",
+		".@@@@@@@@.",
+		"x.x.@@@.x.",
+	}
+	if !slices.Equal(frames[1], first) {
+		t.Errorf("first wave:\n%s\nwant\n%s", strings.Join(frames[1], "\n"), strings.Join(first, "\n"))
+	}
+	for i, want := range waves {
+		if n := strings.Count(strings.Join(frames[i+1], ""), "x"); n != want {
+			t.Errorf("wave %d removes %d rolls, want %d", i+1, n, want)
+		}
+	}
+	rolls := func(rows []string) int { return strings.Count(strings.Join(rows, ""), "@") }
+	if got := rolls(frames[0]) - rolls(frames[len(frames)-1]); got != 43 {
+		t.Errorf("removed %d rolls, want 43", got)
+	}
+}
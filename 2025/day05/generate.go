@@ -0,0 +1,32 @@
+package day05
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+)
+
+// generate writes size fresh ID ranges, some of them overlapping, and 5*size
+// available ingredient IDs below 10^15, about half of which are fresh.
+func generate(r *rand.Rand, size int) []byte {
+	const limit = 1_000_000_000_000_000
+	var b bytes.Buffer
+	starts := make([]int64, size)
+	for i := range starts {
+		starts[i] = r.Int64N(limit)
+		if i > 0 && r.IntN(4) == 0 {
+			// Start inside an earlier range.
+			starts[i] = starts[r.IntN(i)] + r.Int64N(limit/1000)
+		}
+		fmt.Fprintf(&b, "%d-%d\n", starts[i], starts[i]+r.Int64N(limit/100))
+	}
+	b.WriteByte('\n')
+	for range 5 * size {
+		id := r.Int64N(limit)
+		if r.IntN(2) == 0 {
+			id = starts[r.IntN(size)] + r.Int64N(limit/1000)
+		}
+		fmt.Fprintf(&b, "%d\n", id)
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,189 @@
+package day05
+
+import (
+	"fmt"
+	"io"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/grammar"
+	"adventofcode/aoc/interval"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     2025,
+		Day:      5,
+		Part1:    part1,
+		Part2:    part2,
+		Validate: inputGrammar.Check,
+		Generate: generate,
+	})
+}
+
+// inputGrammar is the "start-end" fresh ID ranges, a blank line and the
+// available ingredient IDs.
+var inputGrammar = grammar.Sections(
+	grammar.Each(grammar.Seq(grammar.Uint(), grammar.Lit("-"), grammar.Uint())),
+	grammar.Each(grammar.Uint()),
+)
+
+// readInput splits the database into the fresh ID ranges and the available
+// ingredient IDs.
+func readInput(r io.Reader) (aoc.Section, aoc.Section, error) {
+	sections, err := aoc.Sections(r)
+	if err != nil {
+		return aoc.Section{}, aoc.Section{}, err
+	}
+	if len(sections) != 2 {
+		return aoc.Section{}, aoc.Section{}, fmt.Errorf("expected 2 sections, got %d", len(sections))
+	}
+	return sections[0], sections[1], nil
+}
+
+// scopeBounds splits the "start-end" range on line lineNo into its two
+// numbers.
+func scopeBounds(line string, lineNo int) ([]aoc.Token, error) {
+	parts := aoc.Split(line, "-")
+	if len(parts) != 2 {
+		return nil, aoc.Errorf(lineNo, 0, "expected start-end, got %q", line)
+	}
+	return parts, nil
+}
+
+func part1(r io.Reader) (any, error) {
+	scopes, ingres, err := readInput(r)
+	if err != nil {
+		return nil, err
+	}
+	return solvePart1(scopes, ingres)
+}
+
+func part2(r io.Reader) (any, error) {
+	scopes, _, err := readInput(r)
+	if err != nil {
+		return nil, err
+	}
+	return solvePart2(scopes)
+}
+
+// readScopes builds the set of fresh IDs from the "start-end" ranges.
+func readScopes(scopes aoc.Section) (*interval.Set[uint64], error) {
+	ranges := make([]interval.Interval[uint64], len(scopes.Lines))
+	for i, scope := range scopes.Lines {
+		lineNo := scopes.Line + i
+		parts, err := scopeBounds(scope, lineNo)
+		if err != nil {
+			return nil, err
+		}
+		if ranges[i].Lo, err = parts[0].ParseUint(lineNo); err != nil {
+			return nil, err
+		}
+		if ranges[i].Hi, err = parts[1].ParseUint(lineNo); err != nil {
+			return nil, err
+		}
+		if ranges[i].Empty() {
+			return nil, parts[0].Errorf(lineNo, "range %q ends before it starts", scope)
+		}
+	}
+	return interval.Of(ranges...), nil
+}
+
+// solvePart1 contains the logic for the first part of the puzzle.
+func solvePart1(scopes, ingres aoc.Section) (int, error) {
+	fresh, err := readScopes(scopes)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for i := 0; i < len(ingres.Lines); i++ {
+		ingre := aoc.Token{Text: ingres.Lines[i], Col: 1}.TrimSpace()
+		ingreUInt, err := ingre.ParseUint(ingres.Line + i)
+		if err != nil {
+			return 0, err
+		}
+		if fresh.Contains(ingreUInt) {
+			total++
+		}
+	}
+	return total, nil
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+func solvePart2(scopes aoc.Section) (uint64, error) {
+	fresh, err := readScopes(scopes)
+	if err != nil {
+		return 0, err
+	}
+	total, ok := fresh.Len()
+	if !ok {
+		return 0, fmt.Errorf("the ranges cover all 2^64 IDs")
+	}
+	return total, nil
+}
+// func solvePart2(scopes []string) int64 {
+// 	// Initialize wideleft and wideright
+// 	parts_0 := strings.Split(scopes[0], "-")
+// 	wideLeftInt, _  := strconv.ParseInt(parts_0[0], 10, 64)
+// 	wideRightInt, _ := strconv.ParseInt(parts_0[1], 10, 64)
+//
+// 	total := int64(0)
+//
+// 	// fmt.Printf("total: %d\n", total)
+//
+// 	for i := 1; i < len(scopes); i++ {
+//
+// 		parts := strings.Split(scopes[i], "-")
+// 		leftInt, _  := strconv.ParseInt(parts[0], 10, 64)
+// 		rightInt, _ := strconv.ParseInt(parts[1], 10, 64)
+// 		gap := int64(0)
+//
+// 		// condition 1: there is gap, no overlap
+// 		if rightInt <= wideLeftInt{
+// 			if wideLeftInt == rightInt {
+// 				gap = 0
+// 			} else {
+// 				gap = wideLeftInt - rightInt - 1
+// 			}
+// 			wideLeftInt = leftInt
+// 		}
+//
+// 		// condition 2: only overlap
+// 		if leftInt <= wideLeftInt && wideLeftInt <= rightInt && rightInt <= wideRightInt {
+// 			wideLeftInt = leftInt
+// 		}
+//
+// 		// condition 3: only overlap
+// 		if leftInt <= wideLeftInt && wideRightInt <= rightInt {
+// 			wideLeftInt = leftInt
+// 			wideRightInt = rightInt
+// 		}
+//
+// 		// condition 4: only overlap
+// 		if wideLeftInt <= leftInt && rightInt <= wideRightInt {
+// 		}
+//
+// 		// condition 5: only overlap
+// 		if wideLeftInt <= leftInt && leftInt <= wideRightInt && wideRightInt <= rightInt {
+// 			wideRightInt = rightInt
+// 		}
+//
+// 		// condition 6: only gap
+// 		if wideRightInt <= leftInt {
+// 			if wideRightInt == leftInt {
+// 				gap = 0
+// 			} else {
+// 				gap = leftInt - wideRightInt - 1
+// 			}
+// 			wideRightInt = rightInt
+// 		}
+//
+// 		// ignore overlay, but consider gap
+// 		// fmt.Printf("left: %d, right: %d, total: %d, gap: %d\n", wideLeftInt, wideRightInt, total, gap)
+// 		total = wideRightInt - wideLeftInt + 1 - gap
+// 	}
+// 	return total
+// }
+
+
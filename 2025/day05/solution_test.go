@@ -0,0 +1,26 @@
+package day05
+
+import (
+	"testing"
+
+	"adventofcode/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 5, []aoctest.Example{
+		{Input: "input2.txt", Part: 1},
+		{Input: "input2.txt", Part: 2},
+	})
+}
+
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, 2025, 5, 1, 300)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, 2025, 5, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, 2025, 5, 2, "input.txt")
+}
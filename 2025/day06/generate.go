@@ -0,0 +1,44 @@
+package day06
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+// generate writes a worksheet of size problems, each with four numbers of 1
+// to 4 digits, aligned left or right within the problem's columns.
+func generate(r *rand.Rand, size int) []byte {
+	const numbers = 4
+	rows := make([]strings.Builder, numbers+1)
+	for i := range size {
+		if i > 0 {
+			for j := range rows {
+				rows[j].WriteByte(' ')
+			}
+		}
+		nums := make([]string, numbers)
+		width := 0
+		for j := range nums {
+			lo := []int{1, 10, 100, 1000}[r.IntN(4)]
+			nums[j] = strconv.Itoa(lo + r.IntN(9*lo))
+			width = max(width, len(nums[j]))
+		}
+		left := r.IntN(2) == 0
+		for j, n := range nums {
+			pad := strings.Repeat(" ", width-len(n))
+			if left {
+				rows[j].WriteString(n + pad)
+			} else {
+				rows[j].WriteString(pad + n)
+			}
+		}
+		rows[numbers].WriteString(string("+*"[r.IntN(2)]) + strings.Repeat(" ", width-1))
+	}
+	var b []byte
+	for i := range rows {
+		b = append(b, rows[i].String()...)
+		b = append(b, '\n')
+	}
+	return b
+}
@@ -0,0 +1,169 @@
+package day06
+
+import (
+	"fmt"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/checked"
+	"adventofcode/aoc/grammar"
+	"adventofcode/aoc/trace"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     2025,
+		Day:      6,
+		Part1:    aoc.With(aoc.Lines, solvePart1),
+		Part2:    aoc.With(aoc.Lines, solvePart2),
+		Validate: inputGrammar.Check,
+		Generate: generate,
+	})
+}
+
+// inputGrammar is rows of space separated numbers above a row of operators.
+// Part 2 reads the worksheet column by column, so every row has the same
+// width.
+var inputGrammar = grammar.Lines(grammar.SameWidth(grammar.Footer(
+	grammar.Each(grammar.Seq(grammar.Opt(grammar.Spaces()), grammar.List(grammar.Uint(), grammar.Spaces()), grammar.Opt(grammar.Spaces()))),
+	grammar.Seq(grammar.List(grammar.OneOf("+*"), grammar.Spaces()), grammar.Opt(grammar.Spaces())),
+)))
+
+var tr = trace.New("day06")
+
+// checkSheet reports a worksheet without a row of operators or whose rows
+// differ in width, since the problems are read column by column.
+func checkSheet(lines []string) error {
+	if len(lines) < 2 {
+		return fmt.Errorf("expected rows of numbers and a row of operators, got %d lines", len(lines))
+	}
+	for i, line := range lines {
+		if len(line) != len(lines[0]) {
+			return aoc.Errorf(i+1, 0, "row is %d columns wide, expected %d", len(line), len(lines[0]))
+		}
+	}
+	return nil
+}
+
+// solvePart1 contains the logic for the first part of the puzzle.
+func solvePart1(lines []string) (int, error) {
+	if len(lines) < 2 {
+		return 0, fmt.Errorf("expected rows of numbers and a row of operators, got %d lines", len(lines))
+	}
+	total := 0
+	operatorLine := len(lines)
+	operators := aoc.Fields(lines[operatorLine-1])
+	size := len(operators)
+	fig := []int{}
+
+	for i := 0; i < len(lines)-1; i++ {
+		digits := aoc.Fields(lines[i])
+		if len(digits) != size {
+			return 0, aoc.Errorf(i+1, 0, "row has %d numbers, expected %d", len(digits), size)
+		}
+		for j := 0; j < len(digits); j++ {
+			res, err := digits[j].Atoi(i + 1)
+			if err != nil {
+				return 0, err
+			}
+			fig = append(fig, res)
+		}
+	}
+	var err error
+	for i := 0; i < size; i++ {
+		switch operators[i].Text {
+		case "+":
+			sum := 0
+			for k := i; k < len(fig); k += size {
+				if sum, err = checked.Add(sum, fig[k]); err != nil {
+					return 0, err
+				}
+			}
+			if total, err = checked.Add(total, sum); err != nil {
+				return 0, err
+			}
+		case "*":
+			prod := 1
+			for k := i; k < len(fig); k += size {
+				if prod, err = checked.Mul(prod, fig[k]); err != nil {
+					return 0, err
+				}
+			}
+			if total, err = checked.Add(total, prod); err != nil {
+				return 0, err
+			}
+		default:
+			return 0, operators[i].Errorf(operatorLine, "expected + or *, got %q", operators[i].Text)
+		}
+	}
+	return total, nil
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+func solvePart2(lines []string) (int, error) {
+	if err := checkSheet(lines); err != nil {
+		return 0, err
+	}
+	total := 0
+	rows := len(lines) - 1
+	operators := lines[rows]
+	var digits []int
+	for i := len(lines[0]) - 1; i >= 0; i-- {
+		var digitRune []byte
+		top := 0 // the line of the column's first digit
+		for j := 0; j < rows; j++ {
+			switch c := lines[j][i]; {
+			case c >= '0' && c <= '9':
+				if len(digitRune) == 0 {
+					top = j + 1
+				}
+				digitRune = append(digitRune, c)
+			case c != ' ':
+				return 0, aoc.Errorf(j+1, i+1, "expected a digit or a space, got %q", c)
+			}
+		}
+		if len(digitRune) == 0 {
+			return 0, aoc.Errorf(1, i+1, "column has no digits")
+		}
+		// A column read top to bottom is a number the width of the sheet's
+		// height, which can be too large for an int.
+		res, err := aoc.Token{Text: string(digitRune), Col: i + 1}.Atoi(top)
+		if err != nil {
+			return 0, err
+		}
+		digits = append(digits, res)
+		if operators[i] == '+' {
+			sum := 0
+			for _, val := range digits {
+				if sum, err = checked.Add(sum, val); err != nil {
+					return 0, err
+				}
+			}
+			if total, err = checked.Add(total, sum); err != nil {
+				return 0, err
+			}
+			tr.Debugf("i: %d, digit: %d", i, sum)
+			i--
+			digits = digits[:0]
+		} else if operators[i] == '*' {
+			prod := 1
+			for _, val := range digits {
+				if prod, err = checked.Mul(prod, val); err != nil {
+					return 0, err
+				}
+			}
+			if total, err = checked.Add(total, prod); err != nil {
+				return 0, err
+			}
+			tr.Debugf("i: %d, digit: %d", i, prod)
+			i--
+			digits = digits[:0]
+		} else if operators[i] != ' ' {
+			return 0, aoc.Errorf(rows+1, i+1, "expected + or *, got %q", operators[i])
+		}
+	}
+	if len(digits) > 0 {
+		return 0, aoc.Errorf(rows+1, 1, "numbers in the leftmost columns have no operator")
+	}
+	return total, nil
+}
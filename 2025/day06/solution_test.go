@@ -0,0 +1,52 @@
+package day06
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/aoctest"
+	"adventofcode/aoc/checked"
+)
+
+// TestLongColumn checks that part 2 reports a column whose digits make a
+// number too large for an int, pointing at the column.
+func TestLongColumn(t *testing.T) {
+	lines := append(slices.Repeat([]string{"9"}, 20), "+")
+	_, err := solvePart2(lines)
+	var pe *aoc.ParseError
+	if !errors.As(err, &pe) || pe.Line != 1 || pe.Col != 1 || !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("20 rows of 9 = %v, want an out of range error at line 1, column 1", err)
+	}
+	if got, err := solvePart2(lines[2:]); err != nil || got != 999999999999999999 {
+		t.Errorf("18 rows of 9 = %d, %v", got, err)
+	}
+}
+
+// TestOverflow checks that products too large for an int are reported,
+// not wrapped.
+func TestOverflow(t *testing.T) {
+	lines := append(slices.Repeat([]string{"9999"}, 6), "*   ")
+	for part, solve := range []func([]string) (int, error){solvePart1, solvePart2} {
+		if got, err := solve(lines); !errors.Is(err, checked.ErrOverflow) {
+			t.Errorf("part %d of six rows of 9999 = %d, %v, want an overflow", part+1, got, err)
+		}
+		if got, err := solve(lines[2:]); err != nil || got != 9999*9999*9999*9999 {
+			t.Errorf("part %d of four rows of 9999 = %d, %v", part+1, got, err)
+		}
+	}
+}
+
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, 2025, 6, 1, 1000)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, 2025, 6, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, 2025, 6, 2, "input.txt")
+}
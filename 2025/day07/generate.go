@@ -0,0 +1,40 @@
+package day07
+
+import (
+	"math/rand/v2"
+)
+
+// generate writes a manifold size cells wide and about size rows high: the
+// start in the middle of the first row, then every other row a row of
+// splitters. Splitters are never side by side or at the edge, and sit on
+// the columns a beam split on the row above can reach.
+func generate(r *rand.Rand, size int) []byte {
+	width := max(size|1, 3)
+	start := width / 2
+	row := func(fill func(j int) byte) []byte {
+		line := make([]byte, width+1)
+		for j := range width {
+			line[j] = fill(j)
+		}
+		line[width] = '\n'
+		return line
+	}
+	empty := func(int) byte { return '.' }
+
+	b := row(func(j int) byte {
+		if j == start {
+			return 'S'
+		}
+		return '.'
+	})
+	for i := 1; i < size; i += 2 {
+		b = append(b, row(empty)...)
+		b = append(b, row(func(j int) byte {
+			if j > 0 && j < width-1 && (j-start+i/2)%2 == 0 && r.IntN(3) > 0 {
+				return '^'
+			}
+			return '.'
+		})...)
+	}
+	return b
+}
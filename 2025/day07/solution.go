@@ -0,0 +1,153 @@
+package day07
+
+import (
+	"io"
+	"math/big"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/grammar"
+	"adventofcode/aoc/grid"
+	"adventofcode/aoc/trace"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     2025,
+		Day:      7,
+		Part1:    aoc.With(aoc.Lines, solvePart1),
+		Part2:    aoc.With(aoc.Lines, solvePart2),
+		Validate: inputGrammar.Check,
+		Generate: generate,
+		Animate:  animate,
+	})
+}
+
+// inputGrammar is the start 'S' on the first row above rows of splitters
+// '^'. Beams may be drawn in as '|' or, as in input2.txt, as their timeline
+// count in hex.
+var inputGrammar = grammar.Lines(grammar.SameWidth(grammar.Header(
+	grammar.Chars(".S"),
+	grammar.Each(grammar.Chars(".^|0123456789abcdef")),
+)))
+
+var tr = trace.New("day07")
+
+// readManifold parses the diagram and finds its start. It reports a diagram
+// without a single start 'S' on its first row and rows of differing width.
+// Cells other than '^' and 'S' are empty space, which leaves room for
+// annotated examples such as input2.txt.
+func readManifold(lines []string) (*grid.Grid[byte], grid.Point, error) {
+	g, err := grid.Bytes(lines)
+	if err != nil {
+		return nil, grid.Point{}, err
+	}
+	starts := grid.FindAll(g, 'S')
+	for _, p := range starts {
+		if p.Row != 0 {
+			return nil, grid.Point{}, aoc.Errorf(p.Row+1, p.Col+1, "start 'S' is not on the first row")
+		}
+	}
+	if len(starts) != 1 {
+		return nil, grid.Point{}, aoc.Errorf(1, 0, "expected one start 'S', got %d", len(starts))
+	}
+	return g, starts[0], nil
+}
+
+// traceBeams sends the beam from start down the manifold and returns how
+// often it is split. After each row it calls row, when not nil, with the
+// columns holding a beam in that row, beside its splitters included.
+func traceBeams(g *grid.Grid[byte], start grid.Point, row func(r int, beams map[int]struct{})) int {
+	total := 0
+	beams := map[int]struct{}{start.Col: {}}
+	for r := 1; r < g.Height; r++ {
+		for p, c := range g.Row(r) {
+			if _, exists := beams[p.Col]; c != '^' || !exists {
+				continue
+			}
+			delete(beams, p.Col)
+			// A beam split at the edge leaves the manifold on that side.
+			for _, side := range []grid.Point{grid.Left, grid.Right} {
+				if q := p.Add(side); g.In(q) {
+					beams[q.Col] = struct{}{}
+				}
+			}
+			total += 1
+		}
+		if row != nil {
+			row(r, beams)
+		}
+	}
+	return total
+}
+
+// solvePart1 contains the logic for the first part of the puzzle.
+func solvePart1(lines []string) (int, error) {
+	g, start, err := readManifold(lines)
+	if err != nil {
+		return 0, err
+	}
+	return traceBeams(g, start, nil), nil
+}
+
+// animate draws part 1: the manifold, then the beams reaching one more row
+// per frame, drawn as '|' as in the puzzle.
+func animate(r io.Reader, frame func(rows []string)) error {
+	lines, err := aoc.Lines(r)
+	if err != nil {
+		return err
+	}
+	g, start, err := readManifold(lines)
+	if err != nil {
+		return err
+	}
+	draw := func() []string {
+		return grid.Rows(g, func(p grid.Point, c byte) byte { return c })
+	}
+	frame(draw())
+	traceBeams(g, start, func(r int, beams map[int]struct{}) {
+		for col := range beams {
+			// Rows are drawn once traced, so the beams do not change the
+			// splitters traceBeams has yet to see.
+			p := grid.Point{Row: r, Col: col}
+			if c, _ := g.At(p); c != '^' {
+				g.Set(p, '|')
+			}
+		}
+		frame(draw())
+	})
+	return nil
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+func solvePart2(lines []string) (*big.Int, error) {
+	g, start, err := readManifold(lines)
+	if err != nil {
+		return nil, err
+	}
+	// beams counts the timelines per column, which doubles with every row
+	// of splitters a beam meets. Timelines split off the edge are kept, in
+	// columns outside the grid.
+	beams := map[int]*big.Int{start.Col: big.NewInt(1)}
+	add := func(col int, n *big.Int) {
+		if beams[col] == nil {
+			beams[col] = new(big.Int)
+		}
+		beams[col].Add(beams[col], n)
+	}
+	for r := 2; r < g.Height; r++ {
+		for p, c := range g.Row(r) {
+			if n := beams[p.Col]; c == '^' && n != nil {
+				delete(beams, p.Col)
+				add(p.Add(grid.Left).Col, n)
+				add(p.Add(grid.Right).Col, n)
+			}
+		}
+		tr.Verbosef("row %d: %v", r, beams)
+	}
+	total := new(big.Int)
+	for _, v := range beams {
+		total.Add(total, v)
+	}
+	return total, nil
+}
@@ -0,0 +1,80 @@
+package day07
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"adventofcode/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 7, []aoctest.Example{
+		{Input: "input2.txt", Part: 1},
+		{Input: "input2.txt", Part: 2},
+	})
+}
+
+// The part 2 timeline count roughly doubles with every row of splitters, so
+// sizes run well past where it outgrows an int64.
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, 2025, 7, 1, 600)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, 2025, 7, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, 2025, 7, 2, "input.txt")
+}
+
+// TestAnimate checks the last frame against the puzzle's drawing of the
+// beams. input2.txt has them drawn in already, so the clean diagram is here.
+func TestAnimate(t *testing.T) {
+	manifold := `
+.......S.......
+...............
+.......^.......
+...............
+......^.^......
+...............
+.....^.^.^.....
+...............
+....^.^...^....
+...............
+...^.^...^.^...
+...............
+..^...^.....^..
+...............
+.^.^.^.^.^...^.
+...............`
+	want := strings.Fields(`
+.......S.......
+.......|.......
+......|^|......
+......|.|......
+.....|^|^|.....
+.....|.|.|.....
+....|^|^|^|....
+....|.|.|.|....
+...|^|^|||^|...
+...|.|.|||.|...
+..|^|^|||^|^|..
+..|.|.|||.|.|..
+.|^|||^||.||^|.
+.|.|||.||.||.|.
+|^|^|^|^|^|||^|
+|.|.|.|.|.|||.|`)
+	var frames [][]string
+	input := strings.Join(strings.Fields(manifold), "\n")
+	if err := animate(strings.NewReader(input), func(rows []string) { frames = append(frames, rows) }); err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != len(want) {
+		t.Fatalf("%d frames, want one per row", len(frames))
+	}
+	if last := frames[len(frames)-1]; !slices.Equal(last, want) {
+		t.Errorf("last frame:\n%s\nwant:\n%s", strings.Join(last, "\n"), strings.Join(want, "\n"))
+	}
+}
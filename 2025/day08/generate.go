@@ -0,0 +1,18 @@
+package day08
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+)
+
+// generate writes the positions of size junction boxes spread evenly over a
+// cube 100000 wide. Part 1 connects the 1000 closest pairs, so it needs a few
+// hundred boxes to leave three circuits.
+func generate(r *rand.Rand, size int) []byte {
+	var b bytes.Buffer
+	for range size {
+		fmt.Fprintf(&b, "%d,%d,%d\n", r.IntN(100000), r.IntN(100000), r.IntN(100000))
+	}
+	return b.Bytes()
+}
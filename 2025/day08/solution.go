@@ -0,0 +1,160 @@
+package day08
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/checked"
+	"adventofcode/aoc/dsu"
+	"adventofcode/aoc/grammar"
+	"adventofcode/aoc/trace"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     2025,
+		Day:      8,
+		Part1:    aoc.With(aoc.Lines, solvePart1),
+		Part2:    aoc.With(aoc.Lines, solvePart2),
+		Validate: inputGrammar.Check,
+		Generate: generate,
+	})
+}
+
+// inputGrammar is one X,Y,Z junction box position per line.
+var inputGrammar = grammar.Lines(grammar.Each(grammar.Seq(
+	grammar.Uint(), grammar.Lit(","), grammar.Uint(), grammar.Lit(","), grammar.Uint(),
+)))
+
+var tr = trace.New("day08")
+
+// Connection is a pair of junction boxes and their squared distance.
+type Connection struct {
+	a, b int
+	dist dist128
+}
+
+// dist128 is a squared distance, high word first. Coordinates are below
+// 2^63, so the sum of three squared differences stays below 2^128.
+type dist128 [2]uint64
+
+// less reports whether d is shorter than e.
+func (d dist128) less(e dist128) bool {
+	return d[0] < e[0] || d[0] == e[0] && d[1] < e[1]
+}
+
+// squaredDistance returns the exact squared distance between p and q.
+func squaredDistance(p, q [3]int64) dist128 {
+	var d dist128
+	for k := range p {
+		// Both are non-negative, so the difference cannot overflow.
+		diff := p[k] - q[k]
+		if diff < 0 {
+			diff = -diff
+		}
+		hi, lo := bits.Mul64(uint64(diff), uint64(diff))
+		var carry uint64
+		d[1], carry = bits.Add64(d[1], lo, 0)
+		d[0], _ = bits.Add64(d[0], hi, carry)
+	}
+	return d
+}
+
+// connectionLimit is the number of closest pairs part 1 connects. The
+// puzzle's example connects only 10.
+const connectionLimit = 1000
+
+// readBoxes parses the X,Y,Z position of every junction box. Coordinates
+// are exact integers, so products of them are too.
+func readBoxes(lines []string) ([][3]int64, error) {
+	boxes := make([][3]int64, len(lines))
+	for i, line := range lines {
+		dims := aoc.Split(line, ",")
+		if len(dims) != 3 {
+			return nil, aoc.Errorf(i+1, 0, "expected X,Y,Z, got %q", line)
+		}
+		for k, dim := range dims {
+			dim = dim.TrimSpace()
+			val, err := dim.ParseInt(i + 1)
+			if err != nil {
+				return nil, err
+			}
+			if val < 0 {
+				return nil, dim.Errorf(i+1, "coordinate %d is negative", val)
+			}
+			boxes[i][k] = val
+		}
+	}
+	return boxes, nil
+}
+
+// closestPairs returns every pair of boxes, closest first.
+func closestPairs(boxes [][3]int64) []Connection {
+	var connections []Connection
+	for i := 0; i < len(boxes); i++ {
+		for j := i + 1; j < len(boxes); j++ {
+			connections = append(connections, Connection{i, j, squaredDistance(boxes[i], boxes[j])})
+		}
+	}
+
+	// sort
+	sort.Slice(connections, func(i, j int) bool {
+		return connections[i].dist.less(connections[j].dist)
+	})
+	return connections
+}
+
+// solvePart1 contains the logic for the first part of the puzzle.
+func solvePart1(lines []string) (int, error) {
+	return connectClosest(lines, connectionLimit)
+}
+
+// connectClosest connects the limit closest pairs of junction boxes and
+// multiplies the sizes of the three largest circuits.
+func connectClosest(lines []string, limit int) (int, error) {
+	boxes, err := readBoxes(lines)
+	if err != nil {
+		return 0, err
+	}
+	connections := closestPairs(boxes)
+
+	// Process first limit shortest connections
+	limit = min(limit, len(connections))
+	circuits := dsu.New(len(boxes))
+	for _, c := range connections[:limit] {
+		circuits.Union(c.a, c.b)
+	}
+	sizes := circuits.TopSizes(3)
+	tr.Debugf("largest circuits: %v", sizes)
+	if len(sizes) < 3 {
+		return 0, fmt.Errorf("connecting %d pairs leaves %d circuits, need at least 3", limit, len(sizes))
+	}
+
+	total, err := checked.Mul(sizes[0], sizes[1])
+	if err != nil {
+		return 0, err
+	}
+	return checked.Mul(total, sizes[2])
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+func solvePart2(lines []string) (int64, error) {
+	boxes, err := readBoxes(lines)
+	if err != nil {
+		return 0, err
+	}
+	connections := closestPairs(boxes)
+	tr.Debugf("connections: %d", len(connections))
+
+	// Connect pairs until the connection that leaves a single circuit.
+	circuits := dsu.New(len(boxes))
+	for _, c := range connections {
+		if circuits.Union(c.a, c.b) && circuits.Count() == 1 {
+			return checked.Mul(boxes[c.a][0], boxes[c.b][0])
+		}
+	}
+	return 0, fmt.Errorf("%d junction boxes never form a single circuit", len(boxes))
+}
@@ -0,0 +1,77 @@
+package day08
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/aoctest"
+	"adventofcode/aoc/checked"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 8, []aoctest.Example{
+		{Input: "input2.txt", Part: 2},
+	})
+}
+
+// The example connects the 10 closest pairs rather than connectionLimit, so
+// part 1 is checked on connectClosest directly.
+func TestConnectClosestExample(t *testing.T) {
+	lines, err := aoc.Lines(bytes.NewReader(aoctest.ReadFile(t, "input2.txt")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := connectClosest(lines, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 40; got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+}
+
+// TestLargeCoordinates checks that coordinates past the 2^53 a float64
+// holds exactly, and squared distances past the int64 range, give exact
+// answers, and that a product too large for an int64 is reported.
+func TestLargeCoordinates(t *testing.T) {
+	tests := []struct {
+		lines    []string
+		want     int64
+		overflow bool
+	}{
+		{[]string{"3,0,0", "9007199254740993,0,0", "9007199254740999,0,0"}, 27021597764222979, false},
+		// Squared distances taken modulo 2^64 would join 3 and 4 first and
+		// end on 4 and 5.
+		{[]string{"3,0,0", "4,9223372036854775807,0", "5,9223372036854775807,9223372036854775806"}, 12, false},
+		{[]string{"4000000000,0,0", "5000000000,0,0"}, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := solvePart2(tt.lines)
+		if tt.overflow {
+			if !errors.Is(err, checked.ErrOverflow) {
+				t.Errorf("part 2 of %q = %d, %v, want an overflow", tt.lines, got, err)
+			}
+		} else if err != nil || got != tt.want {
+			t.Errorf("part 2 of %q = %d, %v, want %d", tt.lines, got, err, tt.want)
+		}
+	}
+	if _, err := solvePart2([]string{"1,2,3", "1,-2,3"}); err == nil {
+		t.Error("accepted a negative coordinate")
+	}
+}
+
+// Part 1 connects the 1000 closest pairs and needs three circuits left,
+// which takes a few hundred boxes.
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, 2025, 8, 500, 1000)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, 2025, 8, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, 2025, 8, 2, "input.txt")
+}
@@ -0,0 +1,41 @@
+package day09
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+)
+
+// generate writes the red tiles of a rectilinear polygon with 4*size
+// corners: size columns side by side, each reaching from somewhere below the
+// middle to somewhere above it, walked along their tops and back along
+// their bottoms. Neighbouring columns never share a top or bottom, so no
+// three corners in a row are in line.
+func generate(r *rand.Rand, size int) []byte {
+	const mid = 50000
+	step := max(100000, 2*(size+1)) / (size + 1)
+	xs := make([]int, size+1)
+	for i := range xs {
+		xs[i] = i*step + r.IntN(step-1)
+	}
+	tops := make([]int, size)
+	bottoms := make([]int, size)
+	for i := range size {
+		for {
+			tops[i] = mid + 1 + r.IntN(mid-1)
+			bottoms[i] = r.IntN(mid)
+			if i == 0 || tops[i] != tops[i-1] && bottoms[i] != bottoms[i-1] {
+				break
+			}
+		}
+	}
+
+	var b bytes.Buffer
+	for i := range size {
+		fmt.Fprintf(&b, "%d,%d\n%d,%d\n", xs[i], tops[i], xs[i+1], tops[i])
+	}
+	for i := size - 1; i >= 0; i-- {
+		fmt.Fprintf(&b, "%d,%d\n%d,%d\n", xs[i+1], bottoms[i], xs[i], bottoms[i])
+	}
+	return b.Bytes()
+}
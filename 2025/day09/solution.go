@@ -0,0 +1,109 @@
+package day09
+
+import (
+	"fmt"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/geom"
+	"adventofcode/aoc/grammar"
+	"adventofcode/aoc/trace"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     2025,
+		Day:      9,
+		Part1:    aoc.With(aoc.Lines, solvePart1),
+		Part2:    aoc.With(aoc.Lines, solvePart2),
+		Validate: inputGrammar.Check,
+		Generate: generate,
+	})
+}
+
+// inputGrammar is one X,Y red tile position per line. readPoints also
+// keeps both below geom.Limit, so that the two parts accept the same input.
+var inputGrammar = grammar.Lines(grammar.Each(grammar.Seq(grammar.Uint(), grammar.Lit(","), grammar.Uint())))
+
+var tr = trace.New("day09")
+
+// readPoints parses the X,Y position of every red tile.
+func readPoints(lines []string) ([]geom.Point, error) {
+	points := make([]geom.Point, 0, len(lines))
+	for i, line := range lines {
+		coords := aoc.Split(line, ",")
+		if len(coords) != 2 {
+			return nil, aoc.Errorf(i+1, 0, "expected X,Y, got %q", line)
+		}
+		var p [2]int64
+		for j, c := range coords {
+			c = c.TrimSpace()
+			v, err := c.ParseInt(i + 1)
+			if err != nil {
+				return nil, err
+			}
+			if v <= -geom.Limit || v >= geom.Limit {
+				return nil, c.Errorf(i+1, "coordinate %d is beyond ±%d", v, geom.Limit)
+			}
+			p[j] = v
+		}
+		points = append(points, geom.Point{X: p[0], Y: p[1]})
+	}
+	if len(points) < 2 {
+		return nil, fmt.Errorf("need at least 2 red tiles, got %d", len(points))
+	}
+	return points, nil
+}
+
+// solvePart1 contains the logic for the first part of the puzzle.
+func solvePart1(lines []string) (int64, error) {
+	dots, err := readPoints(lines)
+	if err != nil {
+		return 0, err
+	}
+	var maxArea int64
+	for i := 0; i < len(dots); i++ {
+		for j := i + 1; j < len(dots); j++ {
+			area, err := geom.RectOf(dots[i], dots[j]).Points()
+			if err != nil {
+				return 0, err
+			}
+			maxArea = max(maxArea, area)
+		}
+	}
+	return maxArea, nil
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+//
+// The red tiles are the corners of a rectilinear polygon of green tiles;
+// the largest rectangle with red opposite corners must lie within it.
+func solvePart2(lines []string) (int64, error) {
+	points, err := readPoints(lines)
+	if err != nil {
+		return 0, err
+	}
+	poly, err := geom.NewPolygon(points)
+	if err != nil {
+		return 0, err
+	}
+	if !poly.Rectilinear() {
+		return 0, fmt.Errorf("consecutive red tiles must share a row or column")
+	}
+
+	var maxArea int64
+	for i := 0; i < len(points); i++ {
+		for j := i + 1; j < len(points); j++ {
+			rect := geom.RectOf(points[i], points[j])
+			area, err := rect.Points()
+			if err != nil {
+				return 0, err
+			}
+			if area > maxArea && poly.ContainsRect(rect) {
+				tr.Verbosef("corners %v and %v: %d tiles", points[i], points[j], area)
+				maxArea = area
+			}
+		}
+	}
+	return maxArea, nil
+}
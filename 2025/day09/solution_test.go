@@ -0,0 +1,50 @@
+package day09
+
+import (
+	"errors"
+	"testing"
+
+	"adventofcode/aoc/aoctest"
+	"adventofcode/aoc/checked"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 9, []aoctest.Example{
+		{Input: "input2.txt", Part: 1},
+		{Input: "input2.txt", Part: 2},
+	})
+}
+
+// TestHugeRectangle checks that both parts take coordinates well beyond
+// 32 bits, report a rectangle with more tiles than an int64 holds rather
+// than a wrapped count, and agree on what is out of range.
+func TestHugeRectangle(t *testing.T) {
+	wide := []string{"0,0", "3000000000,0", "3000000000,2", "0,2"}
+	huge := []string{"0,0", "4000000000,0", "4000000000,4000000000", "0,4000000000"}
+	beyond := []string{"0,0", "2305843009213693952,0", "2305843009213693952,1", "0,1"}
+	for part, solve := range map[int]func([]string) (int64, error){1: solvePart1, 2: solvePart2} {
+		if got, err := solve(wide); got != 9000000003 || err != nil {
+			t.Errorf("part %d of a wide rectangle = %d, %v, want 9000000003", part, got, err)
+		}
+		if got, err := solve(huge); !errors.Is(err, checked.ErrOverflow) {
+			t.Errorf("part %d of a huge square = %d, %v, want an overflow", part, got, err)
+		}
+		if got, err := solve(beyond); err == nil {
+			t.Errorf("part %d beyond geom.Limit = %d, want an error", part, got)
+		}
+	}
+}
+
+// Part 2 tries every pair of corners against every edge, so sizes stay
+// small to keep the fuzzer fast.
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, 2025, 9, 1, 100)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, 2025, 9, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, 2025, 9, 2, "input.txt")
+}
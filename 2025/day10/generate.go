@@ -0,0 +1,71 @@
+package day10
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+)
+
+// generate writes size machines of 4 to 10 lights and up to three buttons
+// more than lights. The buttons are linearly independent but for the extra
+// ones, so solvePart2 has at most three free buttons to search. The joltages
+// come from pressing each button at most 15 times, so no counter needs more
+// than 15 times the 13 buttons, and pressLimits caps each free button at
+// that.
+func generate(r *rand.Rand, size int) []byte {
+	var b bytes.Buffer
+	for range size {
+		n := 4 + r.IntN(7)
+		label := r.Perm(n)
+		var buttons [][]int
+		for i := range n {
+			// Button i wires light i and lights after it only, so the
+			// first n buttons are independent.
+			wires := []int{label[i]}
+			for j := i + 1; j < n; j++ {
+				if r.IntN(3) == 0 {
+					wires = append(wires, label[j])
+				}
+			}
+			buttons = append(buttons, wires)
+		}
+		for range r.IntN(4) {
+			wires := []int{r.IntN(n)}
+			for j := range n {
+				if j != wires[0] && r.IntN(3) == 0 {
+					wires = append(wires, j)
+				}
+			}
+			buttons = append(buttons, wires)
+		}
+		r.Shuffle(len(buttons), func(i, j int) { buttons[i], buttons[j] = buttons[j], buttons[i] })
+
+		lights := bytes.Repeat([]byte{'.'}, n)
+		joltage := make([]int, n)
+		var fields []string
+		for _, wires := range buttons {
+			slices.Sort(wires)
+			toggled := r.IntN(2) == 0
+			presses := r.IntN(16)
+			for _, w := range wires {
+				if toggled {
+					lights[w] ^= '.' ^ '#'
+				}
+				joltage[w] += presses
+			}
+			fields = append(fields, "("+join(wires)+")")
+		}
+		fmt.Fprintf(&b, "[%s] %s {%s}\n", lights, strings.Join(fields, " "), join(joltage))
+	}
+	return b.Bytes()
+}
+
+func join(nums []int) string {
+	s := make([]string, len(nums))
+	for i, n := range nums {
+		s[i] = fmt.Sprint(n)
+	}
+	return strings.Join(s, ",")
+}
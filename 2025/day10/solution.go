@@ -0,0 +1,432 @@
+package day10
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	// "sort"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/checked"
+	"adventofcode/aoc/grammar"
+	"adventofcode/aoc/linalg"
+	"adventofcode/aoc/trace"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     2025,
+		Day:      10,
+		Part1:    aoc.With(aoc.Lines, solvePart1),
+		Part2:    aoc.With(aoc.Lines, solvePart2),
+		Validate: inputGrammar.Check,
+		Generate: generate,
+	})
+}
+
+// inputGrammar is one machine per line, e.g.
+// "[.##.] (3) (1,3) (2) {3,5,4,7}".
+var inputGrammar = grammar.Lines(grammar.Each(grammar.Seq(
+	grammar.Lit("["), grammar.Chars(".#"), grammar.Lit("]"),
+	grammar.Spaces(),
+	grammar.List(grammar.Seq(grammar.Lit("("), grammar.List(grammar.Uint(), grammar.Lit(",")), grammar.Lit(")")), grammar.Spaces()),
+	grammar.Spaces(),
+	grammar.Lit("{"), grammar.List(grammar.Uint(), grammar.Lit(",")), grammar.Lit("}"),
+)))
+
+var tr = trace.New("day10")
+
+// machine is one line of the manual: the indicator light diagram, the lights
+// or counters wired to each button and the joltage requirements.
+type machine struct {
+	lights  string // without the brackets, e.g. ".##."
+	buttons [][]int
+	joltage []int
+}
+
+// parseMachine reads a line such as "[.##.] (3) (1,3) (2) {3,5,4,7}".
+func parseMachine(line string, lineNo int) (machine, error) {
+	var m machine
+	fields := aoc.Fields(line)
+	if len(fields) < 2 {
+		return m, aoc.Errorf(lineNo, 0, "expected [lights] (buttons)... {joltage}, got %q", line)
+	}
+
+	diagram := fields[0]
+	if !strings.HasPrefix(diagram.Text, "[") || !strings.HasSuffix(diagram.Text, "]") {
+		return m, diagram.Errorf(lineNo, "expected [lights], got %q", diagram.Text)
+	}
+	m.lights = diagram.Text[1 : len(diagram.Text)-1]
+	for i := 0; i < len(m.lights); i++ {
+		if m.lights[i] != '.' && m.lights[i] != '#' {
+			return m, aoc.Errorf(lineNo, diagram.Col+1+i, "expected '.' or '#', got %q", m.lights[i])
+		}
+	}
+
+	for _, but := range fields[1 : len(fields)-1] {
+		wires, err := parseList(but, "(", ")", lineNo)
+		if err != nil {
+			return m, err
+		}
+		for _, w := range wires {
+			if w < 0 || w >= len(m.lights) {
+				return m, but.Errorf(lineNo, "button %q wires light %d, there are %d", but.Text, w, len(m.lights))
+			}
+		}
+		m.buttons = append(m.buttons, wires)
+	}
+
+	last := fields[len(fields)-1]
+	joltage, err := parseList(last, "{", "}", lineNo)
+	if err != nil {
+		return m, err
+	}
+	if len(joltage) != len(m.lights) {
+		return m, last.Errorf(lineNo, "expected %d joltage requirements, got %d", len(m.lights), len(joltage))
+	}
+	m.joltage = joltage
+	return m, nil
+}
+
+// parseList reads the comma separated integers of tok between open and
+// close, e.g. "(1,3)".
+func parseList(tok aoc.Token, open, close string, lineNo int) ([]int, error) {
+	if !strings.HasPrefix(tok.Text, open) || !strings.HasSuffix(tok.Text, close) || len(tok.Text) < 2 {
+		return nil, tok.Errorf(lineNo, "expected %s...%s, got %q", open, close, tok.Text)
+	}
+	inner := aoc.Token{Text: tok.Text[1 : len(tok.Text)-1], Col: tok.Col + 1}
+	var nums []int
+	for _, item := range inner.Split(",") {
+		n, err := item.TrimSpace().Atoi(lineNo)
+		if err != nil {
+			return nil, err
+		}
+		nums = append(nums, n)
+	}
+	return nums, nil
+}
+
+// solvePart1 contains the logic for the first part of the puzzle.
+func solvePart1(lines []string) (int, error) {
+	solution1 := func (lines []string) (int, error) {
+		// solution1: bfs
+		total := 0
+		for n, line := range lines {
+			m, err := parseMachine(line, n+1)
+			if err != nil {
+				return 0, err
+			}
+			lights := m.lights
+			buts := m.buttons
+			tr.Debugf("lights: %s, buttons: %v", lights, buts)
+			lightVal := 0
+			lenLight := len(lights)
+			for _, li := range lights {
+				lightVal *= 2
+				if li == '#' {
+					lightVal += 1
+				}
+			}
+			
+			butVal := []int{}
+			for _, but := range buts {
+				val := 0
+				for _, vInt := range but {
+					val += 1 << (lenLight - vInt - 1)
+				}
+				butVal = append(butVal, val)
+			}
+			tr.Debugf("lightVal: %b, butVal: %b", lightVal, butVal)
+
+			// bfs
+			d := map[int]int{}
+			d[0] = 0
+			q := []int{0}
+			for len(q) > 0 {
+				current := q[0]
+				q = q[1:]
+
+				if current == lightVal {
+					total += d[lightVal]
+					tr.Debugf("presses: %d", d[lightVal])
+					break
+				}
+				
+				set := make(map[int]struct{})
+				for v, _ := range d {
+					set[v] = struct{}{}
+				}
+				for _, b := range butVal {
+					if _, ok := set[(current ^ b)]; !ok {
+						d[current ^ b] = d[current] + 1
+						q = append(q, current ^ b)
+					}
+				}
+			}
+		}
+		return total, nil
+	}
+	return solution1(lines)
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+func solvePart2(lines []string) (int64, error) {
+	var total int64
+	for n, line := range lines {
+		m, err := parseMachine(line, n+1)
+		if err != nil {
+			return 0, err
+		}
+		best, ok, err := fewestPresses(m)
+		if errors.Is(err, checked.ErrOverflow) {
+			return 0, &aoc.ParseError{Line: n + 1, Msg: "counting presses", Err: err}
+		} else if err != nil {
+			return 0, aoc.Errorf(n+1, 0, "%v", err)
+		}
+		if !ok {
+			return 0, aoc.Errorf(n+1, 0, "no presses reach joltage %v", m.joltage)
+		}
+		if total, err = checked.Add(total, best); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// errContradiction reports a machine whose counters cannot all be met.
+var errContradiction = errors.New("the joltage requirements contradict each other")
+
+// fewestPresses returns the fewest presses that meet the joltage of m, or
+// false if none do. It works in int64 and, if that overflows, does the
+// machine again in big integers, so the count is exact; only a count too
+// large for an int64 is an error, wrapping checked.ErrOverflow.
+func fewestPresses(m machine) (int64, bool, error) {
+	limits := pressLimits(m)
+	s, err := reduce(m)
+	if err == nil {
+		tr.Debugf("pivots: %v, freeVars: %v", s.pivots, s.free)
+		var best int64
+		var ok bool
+		if best, ok, err = s.fewestPresses(limits); err == nil {
+			return best, ok, nil
+		}
+	}
+	if !errors.Is(err, checked.ErrOverflow) {
+		return 0, false, err
+	}
+	tr.Debugf("%v; redoing the machine in big integers", err)
+	bs, err := reduceRat(m)
+	if err != nil {
+		return 0, false, err
+	}
+	best, ok := bs.fewestPresses(limits)
+	if !ok {
+		return 0, false, nil
+	}
+	if !best.IsInt64() {
+		return 0, false, fmt.Errorf("%w: %s presses", checked.ErrOverflow, best)
+	}
+	return best.Int64(), true, nil
+}
+
+// system is a machine's equations, one per counter, in reduced form. Row i
+// reads div[i]*x[pivots[i]] + sum over f of coef[i][f]*x[free[f]] = rhs[i],
+// where x[b] is how often button b is pressed and div[i] > 0.
+type system struct {
+	pivots, free []int
+	div, rhs     []int64
+	coef         [][]int64
+}
+
+// bigSystem is a system in big integers, for machines whose numbers do
+// not fit in an int64.
+type bigSystem struct {
+	pivots, free []int
+	div, rhs     []*big.Int
+	coef         [][]*big.Int
+}
+
+// augmented returns the equations of m as a matrix with a column per
+// button and the joltage requirements in the last column.
+func augmented(m machine) *linalg.IntMatrix {
+	cols := len(m.buttons)
+	aug := linalg.NewInt(len(m.joltage), cols+1)
+	for i, j := range m.joltage {
+		aug.Set(i, cols, int64(j))
+	}
+	for b, wires := range m.buttons {
+		for _, c := range wires {
+			aug.Set(c, b, 1)
+		}
+	}
+	return aug
+}
+
+// reduce row reduces the equations of m exactly and without fractions. It
+// returns an error wrapping checked.ErrOverflow if they leave the int64
+// range; reduceRat can take over then.
+func reduce(m machine) (system, error) {
+	cols := len(m.buttons)
+	aug := augmented(m)
+	tr.Verbosef("augmented matrix:\n%s", aug)
+
+	r, pivots, err := aug.RREF()
+	if err != nil {
+		return system{}, err
+	}
+	if len(pivots) > 0 && pivots[len(pivots)-1] == cols {
+		return system{}, errContradiction
+	}
+	tr.Verbosef("rref:\n%s", r)
+	s := system{pivots: pivots, free: linalg.FreeColumns(pivots, cols)}
+	for i, p := range pivots {
+		s.div = append(s.div, r.At(i, p))
+		s.rhs = append(s.rhs, r.At(i, cols))
+		row := make([]int64, len(s.free))
+		for k, f := range s.free {
+			row[k] = r.At(i, f)
+		}
+		s.coef = append(s.coef, row)
+	}
+	return s, nil
+}
+
+// reduceRat is reduce in rationals, for numbers too large for int64
+// arithmetic. Each row of the rational form is scaled by the common
+// denominator of its entries.
+func reduceRat(m machine) (bigSystem, error) {
+	cols := len(m.buttons)
+	r, pivots := augmented(m).Rat().RREF()
+	if len(pivots) > 0 && pivots[len(pivots)-1] == cols {
+		return bigSystem{}, errContradiction
+	}
+	s := bigSystem{pivots: pivots, free: linalg.FreeColumns(pivots, cols)}
+	for i := range pivots {
+		used := append(slices.Clone(s.free), cols)
+		den := big.NewInt(1)
+		for _, j := range used {
+			d := r.At(i, j).Denom()
+			den.Div(new(big.Int).Mul(den, d), new(big.Int).GCD(nil, nil, den, d))
+		}
+		row := make([]*big.Int, len(used))
+		for k, j := range used {
+			v := r.At(i, j)
+			row[k] = new(big.Int).Mul(v.Num(), new(big.Int).Div(den, v.Denom()))
+		}
+		s.div = append(s.div, den)
+		s.rhs = append(s.rhs, row[len(row)-1])
+		s.coef = append(s.coef, row[:len(row)-1])
+	}
+	return s, nil
+}
+
+// pressLimits returns the most times each button can be pressed: every
+// press adds 1 to each counter it is wired to, so no more than the smallest
+// of their requirements.
+func pressLimits(m machine) []int64 {
+	limits := make([]int64, len(m.buttons))
+	for b, wires := range m.buttons {
+		for k, c := range wires {
+			if j := int64(m.joltage[c]); k == 0 || j < limits[b] {
+				limits[b] = j
+			}
+		}
+	}
+	return limits
+}
+
+// fewestPresses tries every count of the free buttons up to their limit and
+// returns the fewest presses in all that give each pivot button a whole,
+// non-negative count, or false if no counts do. A count that leaves the
+// int64 range is an error wrapping checked.ErrOverflow.
+func (s system) fewestPresses(limits []int64) (int64, bool, error) {
+	best := int64(-1)
+	vals := make([]int64, len(s.free))
+	var err error
+	var try func(k int, sum int64)
+	try = func(k int, sum int64) {
+		if k < len(s.free) {
+			for v := int64(0); v <= limits[s.free[k]] && err == nil; v++ {
+				var next int64
+				if next, err = checked.Add(sum, v); err != nil {
+					return
+				}
+				if best >= 0 && next >= best {
+					return
+				}
+				vals[k] = v
+				try(k+1, next)
+			}
+			return
+		}
+		for i := range s.pivots {
+			num := s.rhs[i]
+			for f, v := range vals {
+				var p int64
+				if p, err = checked.Mul(s.coef[i][f], v); err != nil {
+					return
+				}
+				if num, err = checked.Sub(num, p); err != nil {
+					return
+				}
+			}
+			if num < 0 || num%s.div[i] != 0 {
+				return
+			}
+			if sum, err = checked.Add(sum, num/s.div[i]); err != nil {
+				return
+			}
+		}
+		if best < 0 || sum < best {
+			best = sum
+		}
+	}
+	try(0, 0)
+	if err != nil {
+		return 0, false, err
+	}
+	return best, best >= 0, nil
+}
+
+// fewestPresses is system.fewestPresses in big integers.
+func (s bigSystem) fewestPresses(limits []int64) (*big.Int, bool) {
+	var best *big.Int
+	vals := make([]int64, len(s.free))
+	num, p, q, rem := new(big.Int), new(big.Int), new(big.Int), new(big.Int)
+	var try func(k int, sum *big.Int)
+	try = func(k int, sum *big.Int) {
+		if k < len(s.free) {
+			for v := int64(0); v <= limits[s.free[k]]; v++ {
+				next := new(big.Int).Add(sum, big.NewInt(v))
+				if best != nil && next.Cmp(best) >= 0 {
+					return
+				}
+				vals[k] = v
+				try(k+1, next)
+			}
+			return
+		}
+		total := new(big.Int).Set(sum)
+		for i := range s.pivots {
+			num.Set(s.rhs[i])
+			for f, v := range vals {
+				num.Sub(num, p.Mul(s.coef[i][f], big.NewInt(v)))
+			}
+			if num.Sign() < 0 {
+				return
+			}
+			if q.QuoRem(num, s.div[i], rem); rem.Sign() != 0 {
+				return
+			}
+			total.Add(total, q)
+		}
+		if best == nil || total.Cmp(best) < 0 {
+			best = total
+		}
+	}
+	try(0, new(big.Int))
+	return best, best != nil
+}
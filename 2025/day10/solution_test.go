@@ -0,0 +1,89 @@
+package day10
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/aoctest"
+	"adventofcode/aoc/checked"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 10, []aoctest.Example{
+		{Input: "input2.txt", Part: 1},
+		{Input: "input2.txt", Part: 2},
+	})
+}
+
+// TestOverflow checks that part 2 reports press counts it cannot hold in an
+// int64 rather than wrapping them.
+func TestOverflow(t *testing.T) {
+	tests := []struct {
+		lines    []string
+		want     int64
+		overflow bool
+	}{
+		{[]string{"[..] (0) (1) {4000000000000000000,5000000000000000000}"}, 9000000000000000000, false},
+		{[]string{"[..] (0) (1) {5000000000000000000,5000000000000000000}"}, 0, true},
+		{[]string{"[.] (0) {5000000000000000000}", "[.] (0) {5000000000000000000}"}, 0, true},
+		// The reduced equations hold 10^19 even though no button is pressed
+		// more than 5*10^18 times, so the machine is redone in big integers.
+		{[]string{"[...] (0,1) (1,2) (0,2) (0,1,2) {5000000000000000000,5000000000000000000,0}"}, 5000000000000000000, false},
+	}
+	for _, tt := range tests {
+		got, err := solvePart2(tt.lines)
+		if tt.overflow {
+			if !errors.Is(err, checked.ErrOverflow) {
+				t.Errorf("part 2 of %q = %d, %v, want an overflow", tt.lines, got, err)
+			}
+		} else if err != nil || got != tt.want {
+			t.Errorf("part 2 of %q = %d, %v, want %d", tt.lines, got, err, tt.want)
+		}
+	}
+}
+
+// TestBigSystemAgrees checks the big integer search, which only runs when
+// int64 overflows, against the int64 one on generated machines.
+func TestBigSystemAgrees(t *testing.T) {
+	lines, err := aoc.Lines(bytes.NewReader(aoctest.Generated(t, 2025, 10, 1, 50)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n, line := range lines {
+		m, err := parseMachine(line, n+1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, err := reduce(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, ok, err := s.fewestPresses(pressLimits(m))
+		if err != nil || !ok {
+			t.Fatalf("%s: %d, %v, %v", line, want, ok, err)
+		}
+		bs, err := reduceRat(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, ok := bs.fewestPresses(pressLimits(m)); !ok || !got.IsInt64() || got.Int64() != want {
+			t.Errorf("%s: big search gives %v, %v, want %d", line, got, ok, want)
+		}
+	}
+}
+
+// Part 2 tries every count of each free button up to its limit, so inputs
+// stay at twenty machines to keep each run short.
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, 2025, 10, 1, 20)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, 2025, 10, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, 2025, 10, 2, "input.txt")
+}
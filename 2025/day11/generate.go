@@ -0,0 +1,57 @@
+package day11
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// generate writes a rack of about size devices in layers, each device leading
+// to one to three devices in the next two layers and the last layer to
+// "out". "svr" is the first layer, "fft" and "dac" sit in middle layers and
+// "you" at most ten layers above "out", since part 1 counts its paths one
+// by one.
+func generate(r *rand.Rand, size int) []byte {
+	depth := min(max(size/8, 4), 40)
+	width := max(size/depth, 1)
+
+	used := map[string]bool{"out": true, "you": true, "svr": true, "fft": true, "dac": true}
+	name := func() string {
+		for {
+			n := string([]byte{byte('a' + r.IntN(26)), byte('a' + r.IntN(26)), byte('a' + r.IntN(26))})
+			if !used[n] {
+				used[n] = true
+				return n
+			}
+		}
+	}
+	layers := make([][]string, depth)
+	layers[0] = []string{"svr"}
+	for k := 1; k < depth; k++ {
+		for range 1 + r.IntN(2*width) {
+			layers[k] = append(layers[k], name())
+		}
+	}
+	special := []string{"fft", "dac"}
+	r.Shuffle(2, func(i, j int) { special[i], special[j] = special[j], special[i] })
+	layers[1+(depth-2)/3] = append(layers[1+(depth-2)/3], special[0])
+	layers[1+2*(depth-2)/3] = append(layers[1+2*(depth-2)/3], special[1])
+	layers[max(depth-10, 1)] = append(layers[max(depth-10, 1)], "you")
+
+	var b strings.Builder
+	for k, layer := range layers {
+		for _, device := range layer {
+			var outputs []string
+			if k == depth-1 {
+				outputs = []string{"out"}
+			} else {
+				for range 1 + r.IntN(3) {
+					next := min(k+1+r.IntN(2), depth-1)
+					outputs = append(outputs, layers[next][r.IntN(len(layers[next]))])
+				}
+			}
+			fmt.Fprintf(&b, "%s: %s\n", device, strings.Join(outputs, " "))
+		}
+	}
+	return []byte(b.String())
+}
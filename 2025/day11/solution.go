@@ -0,0 +1,115 @@
+package day11
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	// "strconv"
+	// "regexp"
+	// "sort"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/grammar"
+	"adventofcode/aoc/graph"
+	"adventofcode/aoc/trace"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     2025,
+		Day:      11,
+		Part1:    aoc.With(aoc.Lines, solvePart1),
+		Part2:    aoc.With(aoc.Lines, solvePart2),
+		Validate: inputGrammar.Check,
+		Generate: generate,
+	})
+}
+
+// inputGrammar is one device per line with the devices its outputs lead
+// to, e.g. "aaa: you hhh".
+var inputGrammar = grammar.Lines(grammar.Each(grammar.Seq(
+	grammar.Word(), grammar.Lit(":"), grammar.Spaces(), grammar.List(grammar.Word(), grammar.Spaces()),
+)))
+
+var tr = trace.New("day11")
+
+// checkDevices reports lines that are not of the form "name: output...".
+func checkDevices(lines []string) error {
+	for i, line := range lines {
+		name, outputs, ok := strings.Cut(line, ":")
+		if !ok {
+			return aoc.Errorf(i+1, 0, "expected name: outputs, got %q", line)
+		}
+		if strings.TrimSpace(name) == "" {
+			return aoc.Errorf(i+1, 1, "missing device name")
+		}
+		if len(aoc.Fields(outputs)) == 0 {
+			return aoc.Errorf(i+1, len(name)+2, "device %q has no outputs", name)
+		}
+	}
+	return nil
+}
+
+// readDevices checks the lines and builds the graph of devices.
+func readDevices(lines []string) (*graph.Graph, error) {
+	if err := checkDevices(lines); err != nil {
+		return nil, err
+	}
+	return graph.Parse(lines)
+}
+
+// devices looks up the named devices, which must all be listed.
+func devices(g *graph.Graph, names ...string) ([]graph.ID, error) {
+	ids := make([]graph.ID, len(names))
+	for i, name := range names {
+		id, ok := g.Lookup(name)
+		if !ok || !g.Declared(id) {
+			return nil, fmt.Errorf("device %q is not listed", name)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// solvePart1 contains the logic for the first part of the puzzle.
+func solvePart1(lines []string) (*big.Int, error) {
+	g, err := readDevices(lines)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := devices(g, "you")
+	if err != nil {
+		return nil, err
+	}
+	you := ids[0]
+	out, ok := g.Lookup("out")
+	if !ok {
+		return new(big.Int), nil
+	}
+	for id, seen := range g.Reachable(you) {
+		if seen && !g.Declared(graph.ID(id)) && graph.ID(id) != out {
+			return nil, fmt.Errorf("device %q is not listed", g.Name(graph.ID(id)))
+		}
+	}
+	return g.CountPaths(you, out)
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+// Counts the paths from svr to out that pass both fft and dac.
+func solvePart2(lines []string) (*big.Int, error) {
+	g, err := readDevices(lines)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := devices(g, "svr", "fft", "dac")
+	if err != nil {
+		return nil, err
+	}
+	out, ok := g.Lookup("out")
+	if !ok {
+		return new(big.Int), nil
+	}
+	tr.Verbosef("%d devices", g.Len())
+	return g.CountPathsVia(ids[0], out, ids[1:]...)
+}
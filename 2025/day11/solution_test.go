@@ -0,0 +1,26 @@
+package day11
+
+import (
+	"testing"
+
+	"adventofcode/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 11, []aoctest.Example{
+		{Input: "input2.txt", Part: 1},
+		{Input: "input3.txt", Part: 2},
+	})
+}
+
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, 2025, 11, 1, 1000)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, 2025, 11, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, 2025, 11, 2, "input.txt")
+}
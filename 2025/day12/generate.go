@@ -0,0 +1,30 @@
+package day12
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+)
+
+// generate writes six 3x3 present shapes of 5 to 7 cells and size regions
+// 35 to 50 units on a side, some of which have room for their presents and
+// some of which clearly do not.
+func generate(r *rand.Rand, size int) []byte {
+	var b bytes.Buffer
+	for i := range 6 {
+		fmt.Fprintf(&b, "%d:\n", i)
+		cells := bytes.Repeat([]byte{'.'}, 9)
+		for _, c := range r.Perm(9)[:5+r.IntN(3)] {
+			cells[c] = '#'
+		}
+		fmt.Fprintf(&b, "%s\n%s\n%s\n\n", cells[:3], cells[3:6], cells[6:])
+	}
+	for range size {
+		fmt.Fprintf(&b, "%dx%d:", 35+r.IntN(16), 35+r.IntN(16))
+		for range 6 {
+			fmt.Fprintf(&b, " %d", 20+r.IntN(45))
+		}
+		b.WriteByte('\n')
+	}
+	return b.Bytes()
+}
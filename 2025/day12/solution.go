@@ -0,0 +1,108 @@
+package day12
+
+import (
+	"fmt"
+	"regexp"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/grammar"
+	"adventofcode/aoc/trace"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     2025,
+		Day:      12,
+		Part1:    aoc.With(aoc.Lines, solvePart1),
+		Part2:    aoc.With(aoc.Lines, solvePart2),
+		Validate: inputGrammar.Check,
+		Generate: generate,
+	})
+}
+
+// shapeGrammar is an index line such as "0:" above a 3x3 present shape.
+var shapeGrammar = grammar.Height(4, grammar.Header(
+	grammar.Seq(grammar.Uint(), grammar.Lit(":")),
+	grammar.SameWidth(grammar.Each(grammar.Chars("#."))),
+))
+
+// inputGrammar is the six shapes solvePart1 expects in the first 30 lines,
+// followed by the regions, e.g. "12x5: 1 0 1 0 2 2".
+var inputGrammar = grammar.Sections(
+	shapeGrammar, shapeGrammar, shapeGrammar, shapeGrammar, shapeGrammar, shapeGrammar,
+	grammar.Each(grammar.Seq(
+		grammar.Uint(), grammar.Lit("x"), grammar.Uint(), grammar.Lit(":"),
+		grammar.Spaces(), grammar.List(grammar.Uint(), grammar.Spaces()),
+	)),
+)
+
+var tr = trace.New("day12")
+
+// solvePart1 contains the logic for the first part of the puzzle.
+type Shape struct {
+	dot int
+}
+
+func solvePart1(lines []string) (int, error) {
+	if len(lines) < 30 {
+		return 0, fmt.Errorf("expected 30 lines of shapes before the regions, got %d lines", len(lines))
+	}
+	var shapes []Shape
+	total := 0
+	shape := Shape{dot: 0}
+	for i := 0; i < 30; i++ {
+		if len(lines[i]) > 1 && lines[i][1] == byte(':') {
+			shape = Shape{dot: 0}
+			continue
+		}
+		if len(lines[i]) == 0 {
+			shapes = append(shapes, shape)
+			continue
+		}
+		for _, ch := range lines[i] {
+			if ch == rune('#') {
+				shape.dot += 1
+			}
+		}
+	}
+
+	for i := 30; i < len(lines); i++ {
+		re := regexp.MustCompile(`\d+`)
+		matches := re.FindAllStringIndex(lines[i], -1)
+		nums := make([]int, len(matches))
+		for j, loc := range matches {
+			tok := aoc.Token{Text: lines[i][loc[0]:loc[1]], Col: loc[0] + 1}
+			num, err := tok.Atoi(i + 1)
+			if err != nil {
+				return 0, err
+			}
+			nums[j] = num
+		}
+		if len(nums) < 2 {
+			return 0, aoc.Errorf(i+1, 0, "expected WxH: counts..., got %q", lines[i])
+		}
+		if len(nums)-2 > len(shapes) {
+			return 0, aoc.Errorf(i+1, 0, "region lists %d shape counts, there are %d shapes", len(nums)-2, len(shapes))
+		}
+
+		width := nums[0]
+		height := nums[1]
+		counts := nums[2:]
+		sums := float64(0)
+		for i, count := range counts {
+			sums += float64(count * shapes[i].dot)
+		}
+		if sums/float64(width*height) < 0.8 {
+			total += 1
+		}
+	}
+	tr.Debugf("shapes: %v", shapes)
+	return total, nil
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+// should use backtracking.
+func solvePart2(lines []string) (int, error) {
+	return 0, nil
+}
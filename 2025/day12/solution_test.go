@@ -0,0 +1,19 @@
+package day12
+
+import (
+	"testing"
+
+	"adventofcode/aoc/aoctest"
+)
+
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, 2025, 12, 1, 1000)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, 2025, 12, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, 2025, 12, 2, "input.txt")
+}
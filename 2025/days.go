@@ -0,0 +1,19 @@
+// Package aoc2025 registers every day of the 2025 puzzle set. Every day
+// registers its solvers with aoc.Register from an init function, so
+// importing the package is all the runner needs.
+package aoc2025
+
+import (
+	_ "adventofcode/2025/day01"
+	_ "adventofcode/2025/day02"
+	_ "adventofcode/2025/day03"
+	_ "adventofcode/2025/day04"
+	_ "adventofcode/2025/day05"
+	_ "adventofcode/2025/day06"
+	_ "adventofcode/2025/day07"
+	_ "adventofcode/2025/day08"
+	_ "adventofcode/2025/day09"
+	_ "adventofcode/2025/day10"
+	_ "adventofcode/2025/day11"
+	_ "adventofcode/2025/day12"
+)
@@ -1,3 +0,0 @@
-module adventofcode25
-
-go 1.25.5
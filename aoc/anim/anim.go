@@ -0,0 +1,178 @@
+// Package anim turns the states of a grid simulation into images: one frame
+// per step, each a grid of characters with a colour per character.
+//
+//	rec := &anim.Recorder{Palette: anim.DefaultPalette(), Scale: 4, Delay: 10}
+//	p.Animate(r, rec.Frame)
+//	err := rec.WriteGIF(f)
+//
+// Days provide the frames through their Puzzle.Animate hook; aoc anim
+// records and writes them.
+package anim
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Palette maps a grid character to its colour.
+type Palette map[byte]color.RGBA
+
+// DefaultPalette returns colours for the characters the puzzles draw with.
+// Characters it lacks get one of a few spare colours; see Recorder.
+func DefaultPalette() Palette {
+	return Palette{
+		'.': {0x0f, 0x0f, 0x23, 0xff}, // empty space, the site's background
+		'#': {0xcc, 0xcc, 0xcc, 0xff},
+		'@': {0xff, 0xff, 0x66, 0xff}, // paper rolls
+		'x': {0xff, 0x55, 0x55, 0xff}, // removed this step
+		'S': {0x00, 0xcc, 0x00, 0xff},
+		'^': {0x99, 0x99, 0xcc, 0xff},
+		'|': {0x66, 0xcc, 0xff, 0xff}, // beams
+	}
+}
+
+// spare colours the characters a palette lacks, in order of appearance.
+var spare = []color.RGBA{
+	{0xff, 0x99, 0x00, 0xff},
+	{0x99, 0xff, 0x99, 0xff},
+	{0xff, 0x66, 0xcc, 0xff},
+	{0x66, 0x66, 0xff, 0xff},
+	{0xff, 0xff, 0xff, 0xff},
+}
+
+// ParsePalette reads colours as comma-separated char=#rrggbb pairs, e.g.
+// "@=#ffff66,.=#000000", over the defaults.
+func ParsePalette(spec string) (Palette, error) {
+	pal := DefaultPalette()
+	if spec == "" {
+		return pal, nil
+	}
+	for _, item := range strings.Split(spec, ",") {
+		char, hex, ok := strings.Cut(item, "=")
+		if !ok || len(char) != 1 {
+			return nil, fmt.Errorf("palette entry %q: expected char=#rrggbb", item)
+		}
+		rgb, err := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+		if err != nil || len(hex) != 7 || hex[0] != '#' {
+			return nil, fmt.Errorf("palette entry %q: expected a colour #rrggbb", item)
+		}
+		pal[char[0]] = color.RGBA{uint8(rgb >> 16), uint8(rgb >> 8), uint8(rgb), 0xff}
+	}
+	return pal, nil
+}
+
+// Recorder collects the frames of an animation. Set its fields before the
+// first Frame; the zero value draws one pixel per cell with the default
+// palette.
+type Recorder struct {
+	Palette Palette // colours of the characters; DefaultPalette when nil
+	Scale   int     // side of a cell in pixels; 1 when less
+	Delay   int     // time per GIF frame in hundredths of a second
+
+	frames [][]string
+}
+
+// Frame records rows, one string per grid row, as the next frame. It
+// copies rows, so the caller may reuse them.
+func (rec *Recorder) Frame(rows []string) {
+	rec.frames = append(rec.frames, slices.Clone(rows))
+}
+
+// Len returns the number of frames recorded.
+func (rec *Recorder) Len() int {
+	return len(rec.frames)
+}
+
+// colours returns the palette shared by every frame, with the index of
+// each character in it.
+func (rec *Recorder) colours() (color.Palette, map[byte]uint8) {
+	pal := rec.Palette
+	if pal == nil {
+		pal = DefaultPalette()
+	}
+	var colours color.Palette
+	index := map[byte]uint8{}
+	for _, rows := range rec.frames {
+		for _, row := range rows {
+			for i := 0; i < len(row); i++ {
+				c := row[i]
+				if _, ok := index[c]; ok {
+					continue
+				}
+				rgba, ok := pal[c]
+				if !ok {
+					rgba = spare[len(index)%len(spare)]
+				}
+				index[c] = uint8(len(colours))
+				colours = append(colours, rgba)
+			}
+		}
+	}
+	if len(colours) == 0 {
+		colours = append(colours, color.Black)
+	}
+	return colours, index
+}
+
+// render draws rows at the recorder's scale. Rows shorter than the widest
+// are padded with the first colour.
+func (rec *Recorder) render(rows []string, colours color.Palette, index map[byte]uint8) *image.Paletted {
+	scale := max(rec.Scale, 1)
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+	img := image.NewPaletted(image.Rect(0, 0, width*scale, len(rows)*scale), colours)
+	for y, row := range rows {
+		for x := 0; x < len(row); x++ {
+			c := index[row[x]]
+			for dy := range scale {
+				off := img.PixOffset(x*scale, y*scale+dy)
+				for dx := range scale {
+					img.Pix[off+dx] = c
+				}
+			}
+		}
+	}
+	return img
+}
+
+// Image returns frame i as an image.
+func (rec *Recorder) Image(i int) image.Image {
+	colours, index := rec.colours()
+	return rec.render(rec.frames[i], colours, index)
+}
+
+// WritePNG writes frame i as a PNG image.
+func (rec *Recorder) WritePNG(w io.Writer, i int) error {
+	if i < 0 || i >= len(rec.frames) {
+		return fmt.Errorf("no frame %d of %d", i, len(rec.frames))
+	}
+	return png.Encode(w, rec.Image(i))
+}
+
+// WriteGIF writes every frame as an animated GIF that loops forever.
+func (rec *Recorder) WriteGIF(w io.Writer) error {
+	if len(rec.frames) == 0 {
+		return errors.New("no frames recorded")
+	}
+	colours, index := rec.colours()
+	anim := &gif.GIF{}
+	for _, rows := range rec.frames {
+		img := rec.render(rows, colours, index)
+		anim.Image = append(anim.Image, img)
+		anim.Delay = append(anim.Delay, rec.Delay)
+		anim.Config.Width = max(anim.Config.Width, img.Rect.Dx())
+		anim.Config.Height = max(anim.Config.Height, img.Rect.Dy())
+	}
+	anim.Config.ColorModel = colours
+	return gif.EncodeAll(w, anim)
+}
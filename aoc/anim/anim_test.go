@@ -0,0 +1,104 @@
+package anim
+
+import (
+	"bytes"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"testing"
+)
+
+func TestParsePalette(t *testing.T) {
+	pal, err := ParsePalette("@=#102030,z=#FFFFFF")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := pal['@'], (color.RGBA{0x10, 0x20, 0x30, 0xff}); got != want {
+		t.Errorf("'@' = %v, want %v", got, want)
+	}
+	if got := pal['z']; got != (color.RGBA{0xff, 0xff, 0xff, 0xff}) {
+		t.Errorf("'z' = %v", got)
+	}
+	if pal['.'] != DefaultPalette()['.'] {
+		t.Errorf("'.' lost its default")
+	}
+	for _, spec := range []string{"@", "ab=#000000", "@=000000", "@=#00000", "@=#gggggg", "@=#000000,"} {
+		if _, err := ParsePalette(spec); err == nil {
+			t.Errorf("ParsePalette(%q) accepted", spec)
+		}
+	}
+}
+
+func TestImageScales(t *testing.T) {
+	rec := &Recorder{Palette: Palette{'#': {0xff, 0, 0, 0xff}, '.': {0, 0, 0xff, 0xff}}, Scale: 3}
+	rec.Frame([]string{"#.", ".#", "?#"})
+	img := rec.Image(0)
+	if b := img.Bounds(); b.Dx() != 6 || b.Dy() != 9 {
+		t.Fatalf("bounds %v, want 6x9", b)
+	}
+	red, blue := color.RGBA{0xff, 0, 0, 0xff}, color.RGBA{0, 0, 0xff, 0xff}
+	for _, tt := range []struct {
+		x, y int
+		want color.RGBA
+	}{{0, 0, red}, {2, 2, red}, {3, 0, blue}, {5, 2, blue}, {0, 3, blue}, {4, 5, red}, {4, 8, red}} {
+		if got := color.RGBAModel.Convert(img.At(tt.x, tt.y)); got != tt.want {
+			t.Errorf("pixel (%d, %d) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+	// '?' is in no palette and takes a spare colour of its own.
+	if got := color.RGBAModel.Convert(img.At(0, 6)); got == red || got == blue {
+		t.Errorf("'?' drawn as %v", got)
+	}
+}
+
+func TestWriteGIF(t *testing.T) {
+	rec := &Recorder{Scale: 2, Delay: 5}
+	if err := rec.WriteGIF(&bytes.Buffer{}); err == nil {
+		t.Error("wrote a GIF without frames")
+	}
+	rows := []string{"@@.", "@.."}
+	rec.Frame(rows)
+	rows[0] = "x@." // Frame keeps its own copy
+	rec.Frame(rows)
+	rec.Frame([]string{"...", "..."})
+
+	var buf bytes.Buffer
+	if err := rec.WriteGIF(&buf); err != nil {
+		t.Fatal(err)
+	}
+	g, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Image) != 3 || g.Config.Width != 6 || g.Config.Height != 4 {
+		t.Fatalf("%d frames of %dx%d, want 3 of 6x4", len(g.Image), g.Config.Width, g.Config.Height)
+	}
+	pal := DefaultPalette()
+	for i, want := range []color.RGBA{pal['@'], pal['x'], pal['.']} {
+		if g.Delay[i] != 5 {
+			t.Errorf("frame %d delay %d, want 5", i, g.Delay[i])
+		}
+		if got := color.RGBAModel.Convert(g.Image[i].At(1, 1)); got != want {
+			t.Errorf("frame %d pixel (1, 1) = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestWritePNG(t *testing.T) {
+	rec := &Recorder{}
+	rec.Frame([]string{"S.", ".^"})
+	if err := rec.WritePNG(&bytes.Buffer{}, 1); err == nil {
+		t.Error("wrote a missing frame")
+	}
+	var buf bytes.Buffer
+	if err := rec.WritePNG(&buf, 0); err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := color.RGBAModel.Convert(img.At(1, 1)), DefaultPalette()['^']; got != want {
+		t.Errorf("pixel (1, 1) = %v, want %v", got, want)
+	}
+}
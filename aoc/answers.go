@@ -0,0 +1,102 @@
+package aoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// AnswersFile is the name of the per-day file holding the accepted answers.
+const AnswersFile = "answers.json"
+
+// Answers maps an input file name, e.g. "input2.txt", to the accepted answer
+// of each part. Answers are strings so that large values survive JSON.
+type Answers map[string]PartAnswers
+
+// PartAnswers holds the answers for one input. An empty string means the
+// answer is not known yet.
+type PartAnswers struct {
+	Part1 string `json:"part1,omitempty"`
+	Part2 string `json:"part2,omitempty"`
+
+	// Rejected1 and Rejected2 are answers the site turned down, kept so
+	// that the same answer is not submitted twice.
+	Rejected1 []Rejected `json:"part1_rejected,omitempty"`
+	Rejected2 []Rejected `json:"part2_rejected,omitempty"`
+}
+
+// Rejected is a wrong answer and the site's hint about it, if any.
+type Rejected struct {
+	Answer string `json:"answer"`
+	Hint   string `json:"hint,omitempty"` // "too high" or "too low"
+}
+
+// Part returns the answer for part 1 or 2.
+func (a PartAnswers) Part(n int) string {
+	switch n {
+	case 1:
+		return a.Part1
+	case 2:
+		return a.Part2
+	}
+	return ""
+}
+
+// RejectedPart returns the rejected answers for part 1 or 2.
+func (a PartAnswers) RejectedPart(n int) []Rejected {
+	switch n {
+	case 1:
+		return a.Rejected1
+	case 2:
+		return a.Rejected2
+	}
+	return nil
+}
+
+// Accept records answer as the accepted answer to part n of input.
+func (a Answers) Accept(input string, n int, answer string) {
+	pa := a[input]
+	switch n {
+	case 1:
+		pa.Part1 = answer
+	case 2:
+		pa.Part2 = answer
+	}
+	a[input] = pa
+}
+
+// Reject records answer as a wrong answer to part n of input.
+func (a Answers) Reject(input string, n int, answer, hint string) {
+	pa := a[input]
+	r := Rejected{Answer: answer, Hint: hint}
+	switch n {
+	case 1:
+		pa.Rejected1 = append(pa.Rejected1, r)
+	case 2:
+		pa.Rejected2 = append(pa.Rejected2, r)
+	}
+	a[input] = pa
+}
+
+// WriteAnswers saves answers in the format of the committed files.
+func WriteAnswers(filename string, answers Answers) error {
+	data, err := json.MarshalIndent(answers, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, append(data, '\n'), 0o644)
+}
+
+// ReadAnswers loads an answers file.
+func ReadAnswers(filename string) (Answers, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not open file: %w", err)
+	}
+
+	var answers Answers
+	if err := json.Unmarshal(data, &answers); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return answers, nil
+}
@@ -0,0 +1,141 @@
+// Package aoctest runs a day's solvers against its example inputs from the
+// day's own tests.
+package aoctest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+
+	"adventofcode/aoc"
+)
+
+// Example is one row of a day's example table: an input file in the day's
+// directory and the part to solve. The expected answer is looked up in the
+// day's answers.json.
+type Example struct {
+	Input string
+	Part  int
+}
+
+// Run solves every example with the solvers registered for year and day and
+// compares the results with answers.json. Examples are also checked against
+// the day's input grammar, if it has one. Tests run in the package
+// directory, so file names are relative to the day.
+func Run(t *testing.T, year, day int, examples []Example) {
+	t.Helper()
+
+	p := lookup(t, year, day)
+	answers, err := aoc.ReadAnswers(aoc.AnswersFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ex := range examples {
+		t.Run(fmt.Sprintf("%s/part%d", ex.Input, ex.Part), func(t *testing.T) {
+			want := answers[ex.Input].Part(ex.Part)
+			if want == "" {
+				t.Skipf("%s has no part %d answer for %s yet", aoc.AnswersFile, ex.Part, ex.Input)
+			}
+			solve, err := p.Part(ex.Part)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data := ReadFile(t, ex.Input)
+			for _, err := range p.Check(data) {
+				t.Errorf("grammar: %v", aoc.InFile(err, ex.Input))
+			}
+			got, err := solve(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("solve: %v", err)
+			}
+			if fmt.Sprint(got) != want {
+				t.Errorf("got %v, want %s", got, want)
+			}
+		})
+	}
+}
+
+// ReadFile returns the contents of an input file, failing the test if it
+// cannot be read.
+func ReadFile(t testing.TB, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// Generated returns the input the generator of year/day makes for seed and
+// size, failing the test if there is none or the day's grammar rejects it.
+func Generated(t testing.TB, year, day int, seed uint64, size int) []byte {
+	t.Helper()
+
+	p := lookup(t, year, day)
+	data, err := p.NewInput(seed, size)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, err := range p.Check(data) {
+		t.Errorf("grammar: seed %d size %d: %v", seed, size, err)
+	}
+	return data
+}
+
+// FuzzGenerated fuzzes both parts of year/day with generated inputs of
+// minSize to maxSize: the fuzzer picks the seed and the size, and both parts
+// have to solve the input without an error. Plain go test runs one input of
+// each bound.
+func FuzzGenerated(f *testing.F, year, day, minSize, maxSize int) {
+	f.Helper()
+
+	p := lookup(f, year, day)
+	f.Add(uint64(1), uint16(0))
+	f.Add(uint64(2), uint16(maxSize-minSize))
+	f.Fuzz(func(t *testing.T, seed uint64, n uint16) {
+		size := minSize + int(n)%(maxSize-minSize+1)
+		data := Generated(t, year, day, seed, size)
+		for part := 1; part <= 2; part++ {
+			if _, err := p.Solve(part, data); err != nil {
+				t.Errorf("seed %d size %d part %d: %v", seed, size, part, err)
+			}
+		}
+	})
+}
+
+// Bench benchmarks part n of year/day on the named input file, normally the
+// real input.txt.
+func Bench(b *testing.B, year, day, n int, input string) {
+	b.Helper()
+
+	solve, err := lookup(b, year, day).Part(n)
+	if err != nil {
+		b.Fatal(err)
+	}
+	BenchSolver(b, solve, ReadFile(b, input))
+}
+
+// BenchSolver is the benchmark loop shared by the day benchmarks and the
+// runner's bench command. Input parsing is part of what is measured.
+func BenchSolver(b *testing.B, solve aoc.Solver, data []byte) {
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := solve(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func lookup(t testing.TB, year, day int) aoc.Puzzle {
+	t.Helper()
+
+	p, ok := aoc.Lookup(year, day)
+	if !ok {
+		t.Fatalf("no solution registered for %d day %02d", year, day)
+	}
+	return p
+}
@@ -0,0 +1,77 @@
+// Package checked is integer arithmetic that reports overflow instead of
+// wrapping around, for answers that may outgrow their type on a larger
+// input:
+//
+//	if total, err = checked.Add(total, id); err != nil {
+//		return 0, err
+//	}
+//
+// Counts that grow exponentially with the input belong in a *big.Int
+// instead; solvers may return one as their answer.
+package checked
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrOverflow is wrapped by every error of the package.
+var ErrOverflow = errors.New("integer overflow")
+
+// Integer is the set of types the arithmetic works on.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+func overflow[T Integer](a T, op string, b T) error {
+	return fmt.Errorf("%w: %d %s %d", ErrOverflow, a, op, b)
+}
+
+// Add returns a+b, or an error if the sum does not fit in T.
+func Add[T Integer](a, b T) (T, error) {
+	c := a + b
+	if b > 0 && c < a || b < 0 && c > a {
+		return 0, overflow(a, "+", b)
+	}
+	return c, nil
+}
+
+// Sub returns a-b, or an error if the difference does not fit in T.
+func Sub[T Integer](a, b T) (T, error) {
+	c := a - b
+	if b > 0 && c > a || b < 0 && c < a {
+		return 0, overflow(a, "-", b)
+	}
+	return c, nil
+}
+
+// Mul returns a*b, or an error if the product does not fit in T.
+func Mul[T Integer](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	// Dividing back catches every wrap; checking both ways also catches
+	// the smallest signed value times -1, which wraps to itself.
+	c := a * b
+	if c/b != a || c/a != b {
+		return 0, overflow(a, "*", b)
+	}
+	return c, nil
+}
+
+// Pow returns base to the power exp, which must not be negative, or an
+// error if it does not fit in T.
+func Pow[T Integer](base T, exp int) (T, error) {
+	if exp < 0 {
+		panic(fmt.Sprintf("checked: negative exponent %d", exp))
+	}
+	result := T(1)
+	for range exp {
+		var err error
+		if result, err = Mul(result, base); err != nil {
+			return 0, fmt.Errorf("%w: %d ^ %d", ErrOverflow, base, exp)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package checked
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// TestAgainstBig checks every pair of int8 and uint8 values, where each
+// result either fits or must be an overflow, against big.Int arithmetic.
+func TestAgainstBig(t *testing.T) {
+	ops := []struct {
+		name string
+		i8   func(a, b int8) (int8, error)
+		u8   func(a, b uint8) (uint8, error)
+		big  func(z, x, y *big.Int) *big.Int
+	}{
+		{"+", Add[int8], Add[uint8], (*big.Int).Add},
+		{"-", Sub[int8], Sub[uint8], (*big.Int).Sub},
+		{"*", Mul[int8], Mul[uint8], (*big.Int).Mul},
+	}
+	check := func(op string, a, b int64, got int64, err error, lo, hi int64, want *big.Int) {
+		t.Helper()
+		if fits := want.IsInt64() && lo <= want.Int64() && want.Int64() <= hi; !fits {
+			if !errors.Is(err, ErrOverflow) {
+				t.Fatalf("%d %s %d = %d, %v, want an overflow", a, op, b, got, err)
+			}
+		} else if err != nil || got != want.Int64() {
+			t.Fatalf("%d %s %d = %d, %v, want %s", a, op, b, got, err, want)
+		}
+	}
+	for _, op := range ops {
+		for a := range 256 {
+			for b := range 256 {
+				sa, sb := int8(a), int8(b)
+				got, err := op.i8(sa, sb)
+				want := op.big(new(big.Int), big.NewInt(int64(sa)), big.NewInt(int64(sb)))
+				check(op.name, int64(sa), int64(sb), int64(got), err, math.MinInt8, math.MaxInt8, want)
+
+				ua, ub := uint8(a), uint8(b)
+				ugot, err := op.u8(ua, ub)
+				want = op.big(new(big.Int), big.NewInt(int64(ua)), big.NewInt(int64(ub)))
+				check(op.name, int64(ua), int64(ub), int64(ugot), err, 0, math.MaxUint8, want)
+			}
+		}
+	}
+}
+
+func TestInt64Extremes(t *testing.T) {
+	if _, err := Mul(int64(math.MinInt64), -1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("MinInt64 * -1: %v", err)
+	}
+	if _, err := Mul(int64(-1), math.MinInt64); !errors.Is(err, ErrOverflow) {
+		t.Errorf("-1 * MinInt64: %v", err)
+	}
+	if _, err := Add(int64(math.MaxInt64), 1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("MaxInt64 + 1: %v", err)
+	}
+	if got, err := Sub(int64(-1), math.MaxInt64); err != nil || got != math.MinInt64 {
+		t.Errorf("-1 - MaxInt64 = %d, %v", got, err)
+	}
+}
+
+func TestPow(t *testing.T) {
+	if got, err := Pow(int64(10), 18); err != nil || got != 1e18 {
+		t.Errorf("10^18 = %d, %v", got, err)
+	}
+	if _, err := Pow(int64(10), 19); !errors.Is(err, ErrOverflow) {
+		t.Errorf("10^19: %v, want an overflow", err)
+	}
+	if got, err := Pow(uint64(10), 19); err != nil || got != 1e19 {
+		t.Errorf("uint64 10^19 = %d, %v", got, err)
+	}
+	if got, err := Pow(-2, 3); err != nil || got != -8 {
+		t.Errorf("(-2)^3 = %d, %v", got, err)
+	}
+}
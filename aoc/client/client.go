@@ -0,0 +1,348 @@
+// Package client downloads puzzle inputs and examples from the Advent of
+// Code site and submits answers. Downloads are cached on disk, requests are
+// spaced out, and the site's "please wait" responses are remembered so that
+// the next submission is held back locally rather than sent.
+//
+// The base URL is configurable, so tests run against an httptest server:
+//
+//	c := client.New(srv.URL, "session", t.TempDir())
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBaseURL is the Advent of Code site.
+const DefaultBaseURL = "https://adventofcode.com"
+
+// Environment variables read by the runner's fetch and submit commands.
+const (
+	SessionEnvVar = "AOC_SESSION"  // value of the site's session cookie
+	BaseURLEnvVar = "AOC_BASE_URL" // overrides DefaultBaseURL
+)
+
+// DefaultInterval is the default minimum time between two requests.
+const DefaultInterval = 3 * time.Second
+
+// userAgent identifies the tool to the site, as its operators ask.
+const userAgent = "github.com/ShaneSkyWalker/adventofcode client"
+
+// ErrThrottled is returned, wrapped, when a submission is held back because
+// the site asked to wait.
+var ErrThrottled = errors.New("throttled")
+
+// Client talks to one Advent of Code site.
+type Client struct {
+	BaseURL  string
+	Session  string
+	CacheDir string // empty disables the cache and the submission throttle
+
+	// Interval is the minimum time between two requests.
+	Interval time.Duration
+
+	HTTP *http.Client
+
+	mu   sync.Mutex
+	last time.Time
+
+	// now and sleep are replaced in tests.
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// New returns a client for the site at baseURL, authenticated with the given
+// session cookie, caching under cacheDir.
+func New(baseURL, session, cacheDir string) *Client {
+	return &Client{
+		BaseURL:  strings.TrimRight(baseURL, "/"),
+		Session:  session,
+		CacheDir: cacheDir,
+		Interval: DefaultInterval,
+		HTTP:     &http.Client{Timeout: 30 * time.Second},
+		now:      time.Now,
+		sleep:    sleepCtx,
+	}
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Input returns the puzzle input of year/day, from the cache if it was
+// downloaded before.
+func (c *Client) Input(ctx context.Context, year, day int) ([]byte, error) {
+	return c.cached(ctx, year, day, "input.txt", fmt.Sprintf("/%d/day/%d/input", year, day))
+}
+
+// Page returns the puzzle page of year/day. The page gains part 2 once part
+// 1 is solved, so Submit drops the cached copy after a right answer.
+func (c *Client) Page(ctx context.Context, year, day int) ([]byte, error) {
+	return c.cached(ctx, year, day, "page.html", fmt.Sprintf("/%d/day/%d", year, day))
+}
+
+// codeBlock matches the preformatted blocks in which the puzzle text shows
+// its examples.
+var codeBlock = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+
+var tag = regexp.MustCompile(`<[^>]*>`)
+
+// Examples returns the text of every <pre><code> block on the puzzle page,
+// in page order. Not every block is an example input; the caller picks.
+func (c *Client) Examples(ctx context.Context, year, day int) ([]string, error) {
+	page, err := c.Page(ctx, year, day)
+	if err != nil {
+		return nil, err
+	}
+	var blocks []string
+	for _, m := range codeBlock.FindAllSubmatch(page, -1) {
+		blocks = append(blocks, html.UnescapeString(tag.ReplaceAllString(string(m[1]), "")))
+	}
+	return blocks, nil
+}
+
+// Verdict is the site's response to a submitted answer.
+type Verdict int
+
+const (
+	Unknown Verdict = iota
+	Correct
+	Incorrect
+	// TooSoon means the answer was not checked because the previous
+	// submission was too recent.
+	TooSoon
+	// WrongLevel means the part is already solved or not yet unlocked.
+	WrongLevel
+)
+
+var verdictNames = []string{"unknown", "correct", "incorrect", "too soon", "wrong level"}
+
+func (v Verdict) String() string {
+	if v >= 0 && int(v) < len(verdictNames) {
+		return verdictNames[v]
+	}
+	return fmt.Sprintf("Verdict(%d)", int(v))
+}
+
+// Result is a parsed answer response.
+type Result struct {
+	Verdict Verdict
+	Hint    string        // "too high" or "too low" for some wrong answers
+	Wait    time.Duration // how long the site asks to wait before the next answer
+	Message string        // the response text without markup
+}
+
+// Submit sends answer for part of year/day. If an earlier response asked
+// to wait and the time is not up, nothing is sent and the error wraps
+// ErrThrottled.
+func (c *Client) Submit(ctx context.Context, year, day, part int, answer string) (Result, error) {
+	if until, ok := c.submitAfter(year); ok && c.now().Before(until) {
+		return Result{}, fmt.Errorf("%w: the site asked to wait until %s", ErrThrottled, until.Format(time.TimeOnly))
+	}
+
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	body, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Result{}, err
+	}
+
+	res := parseResult(body)
+	if res.Wait > 0 {
+		if err := c.setSubmitAfter(year, c.now().Add(res.Wait)); err != nil {
+			return res, err
+		}
+	}
+	if res.Verdict == Correct && c.CacheDir != "" {
+		os.Remove(c.cachePath(year, day, "page.html"))
+	}
+	return res, nil
+}
+
+var (
+	article = regexp.MustCompile(`(?s)<article>(.*?)</article>`)
+	// "You have 1m 12s left to wait." after answering too soon.
+	leftToWait = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	// "please wait one minute" or "wait 5 minutes" after a wrong answer.
+	waitMinutes = regexp.MustCompile(`wait (one|\d+) minutes?`)
+)
+
+// parseResult reads the verdict out of an answer response page.
+func parseResult(page []byte) Result {
+	text := string(page)
+	if m := article.FindStringSubmatch(text); m != nil {
+		text = m[1]
+	}
+	text = strings.Join(strings.Fields(html.UnescapeString(tag.ReplaceAllString(text, ""))), " ")
+
+	res := Result{Message: text}
+	switch {
+	case strings.Contains(text, "That's the right answer"):
+		res.Verdict = Correct
+	case strings.Contains(text, "That's not the right answer"):
+		res.Verdict = Incorrect
+		switch {
+		case strings.Contains(text, "too high"):
+			res.Hint = "too high"
+		case strings.Contains(text, "too low"):
+			res.Hint = "too low"
+		}
+		if m := waitMinutes.FindStringSubmatch(text); m != nil {
+			minutes := 1
+			if m[1] != "one" {
+				minutes, _ = strconv.Atoi(m[1])
+			}
+			res.Wait = time.Duration(minutes) * time.Minute
+		}
+	case strings.Contains(text, "You gave an answer too recently"):
+		res.Verdict = TooSoon
+		if m := leftToWait.FindStringSubmatch(text); m != nil {
+			minutes, _ := strconv.Atoi(m[1])
+			seconds, _ := strconv.Atoi(m[2])
+			res.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+		}
+	case strings.Contains(text, "You don't seem to be solving the right level"):
+		res.Verdict = WrongLevel
+	}
+	return res
+}
+
+// cached returns the cache file name under year/day, downloading it from
+// path first if it is not there.
+func (c *Client) cached(ctx context.Context, year, day int, name, path string) ([]byte, error) {
+	file := c.cachePath(year, day, name)
+	if c.CacheDir != "" {
+		if data, err := os.ReadFile(file); err == nil {
+			return data, nil
+		}
+	}
+
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.CacheDir != "" {
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(file, data, 0o644); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+func (c *Client) cachePath(year, day int, name string) string {
+	return filepath.Join(c.siteDir(), strconv.Itoa(year), fmt.Sprintf("%02d", day), name)
+}
+
+// siteDir returns the cache directory of the client's site, named after its
+// host. Each site has its own, so that an input downloaded from one is never
+// served for another.
+func (c *Client) siteDir() string {
+	host := c.BaseURL
+	if u, err := url.Parse(c.BaseURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	// A port's colon is not allowed in a Windows file name.
+	return filepath.Join(c.CacheDir, strings.NewReplacer(":", "_", "/", "_").Replace(host))
+}
+
+// do sends one request, waiting first so that requests are at least
+// Interval apart.
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	if err := c.throttle(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	if c.Session != "" {
+		req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		msg := strings.TrimSpace(string(data))
+		if len(msg) > 200 {
+			msg = msg[:200] + "..."
+		}
+		return nil, fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, msg)
+	}
+	return data, nil
+}
+
+func (c *Client) throttle(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.last.IsZero() {
+		if wait := c.last.Add(c.Interval).Sub(c.now()); wait > 0 {
+			if err := c.sleep(ctx, wait); err != nil {
+				return err
+			}
+		}
+	}
+	c.last = c.now()
+	return nil
+}
+
+// The time before which no answer may be sent is kept in the cache, per
+// site and year, so that it holds across runs of the command.
+
+func (c *Client) submitAfter(year int) (time.Time, bool) {
+	if c.CacheDir == "" {
+		return time.Time{}, false
+	}
+	data, err := os.ReadFile(filepath.Join(c.siteDir(), strconv.Itoa(year), "submit_after"))
+	if err != nil {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, strings.TrimSpace(string(data)))
+	return t, err == nil
+}
+
+func (c *Client) setSubmitAfter(year int, t time.Time) error {
+	if c.CacheDir == "" {
+		return nil
+	}
+	dir := filepath.Join(c.siteDir(), strconv.Itoa(year))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "submit_after"), []byte(t.Format(time.RFC3339)+"\n"), 0o644)
+}
@@ -0,0 +1,260 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const session = "s3cret"
+
+// standIn is a stand-in for the puzzle site serving 2025 day 1. The right
+// answer to part 1 is 3.
+type standIn struct {
+	*httptest.Server
+	hits atomic.Int32
+}
+
+func newStandIn(t *testing.T) *standIn {
+	s := &standIn{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2025/day/1/input", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("L68\nL30\nR48\n"))
+	})
+	mux.HandleFunc("GET /2025/day/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<main><article><p>For example:</p>
+<pre><code>L68
+L30
+R48
+</code></pre>
+<p>The dial points at 0 <code><em>3</em></code> times.</p>
+<pre><code>a &lt;- <em>b</em></code></pre></article></main>`))
+	})
+	mux.HandleFunc("POST /2025/day/1/answer", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.FormValue("level") != "1":
+			w.Write([]byte(`<article><p>You don't seem to be solving the right level.  Did you already complete it?</p></article>`))
+		case r.FormValue("answer") == "3":
+			w.Write([]byte(`<article><p>That's the right answer!  You are one gold star closer.</p></article>`))
+		default:
+			w.Write([]byte(`<article><p>That's not the right answer; your answer is too high.  Please wait one minute before trying again.</p></article>`))
+		}
+	})
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.hits.Add(1)
+		if c, err := r.Cookie("session"); err != nil || c.Value != session {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		if r.UserAgent() != userAgent {
+			http.Error(w, "missing User-Agent", http.StatusForbidden)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// fakeClock stands in for time.Now and sleeping.
+type fakeClock struct {
+	now   time.Time
+	slept []time.Duration
+}
+
+func newTestClient(t *testing.T, baseURL, session string) (*Client, *fakeClock) {
+	c := New(baseURL, session, t.TempDir())
+	clock := &fakeClock{now: time.Date(2025, 12, 1, 5, 0, 0, 0, time.UTC)}
+	c.now = func() time.Time { return clock.now }
+	c.sleep = func(ctx context.Context, d time.Duration) error {
+		clock.slept = append(clock.slept, d)
+		clock.now = clock.now.Add(d)
+		return nil
+	}
+	return c, clock
+}
+
+func TestInputIsCached(t *testing.T) {
+	srv := newStandIn(t)
+	c, _ := newTestClient(t, srv.URL, session)
+
+	for range 2 {
+		got, err := c.Input(context.Background(), 2025, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != "L68\nL30\nR48\n" {
+			t.Errorf("got %q", got)
+		}
+	}
+	if n := srv.hits.Load(); n != 1 {
+		t.Errorf("server was hit %d times, want 1", n)
+	}
+	if _, err := os.Stat(c.cachePath(2025, 1, "input.txt")); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCacheIsPerSite(t *testing.T) {
+	srv := newStandIn(t)
+	c, _ := newTestClient(t, srv.URL, session)
+	if _, err := c.Input(context.Background(), 2025, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("R1\n"))
+	}))
+	defer other.Close()
+	c2 := New(other.URL, session, c.CacheDir)
+	c2.Interval = 0
+	got, err := c2.Input(context.Background(), 2025, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "R1\n" {
+		t.Errorf("got %q, the input cached for %s", got, srv.URL)
+	}
+	if c.cachePath(2025, 1, "input.txt") == c2.cachePath(2025, 1, "input.txt") {
+		t.Error("both sites cache input.txt in the same file")
+	}
+	if dir := filepath.Dir(filepath.Dir(filepath.Dir(c.cachePath(2025, 1, "input.txt")))); filepath.Dir(dir) != c.CacheDir || strings.ContainsRune(filepath.Base(dir), ':') {
+		t.Errorf("site directory %s, want a directory of %s named after the host", dir, c.CacheDir)
+	}
+}
+
+func TestInputNeedsSession(t *testing.T) {
+	srv := newStandIn(t)
+	c, _ := newTestClient(t, srv.URL, "")
+
+	if _, err := c.Input(context.Background(), 2025, 1); err == nil {
+		t.Fatal("expected an error without a session")
+	}
+	if _, err := os.Stat(c.cachePath(2025, 1, "input.txt")); err == nil {
+		t.Error("the error page was cached")
+	}
+}
+
+func TestExamples(t *testing.T) {
+	srv := newStandIn(t)
+	c, _ := newTestClient(t, srv.URL, session)
+
+	got, err := c.Examples(context.Background(), 2025, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"L68\nL30\nR48\n", "a <- b"}
+	if len(got) != len(want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("block %d: got %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	srv := newStandIn(t)
+	c, clock := newTestClient(t, srv.URL, session)
+	ctx := context.Background()
+
+	res, err := c.Submit(ctx, 2025, 1, 1, "5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Verdict != Incorrect || res.Hint != "too high" || res.Wait != time.Minute {
+		t.Errorf("got %+v, want incorrect, too high, wait 1m", res)
+	}
+
+	// The site asked for a minute; a second answer is held back locally.
+	hits := srv.hits.Load()
+	if _, err := c.Submit(ctx, 2025, 1, 1, "3"); !errors.Is(err, ErrThrottled) {
+		t.Errorf("got %v, want ErrThrottled", err)
+	}
+	if srv.hits.Load() != hits {
+		t.Error("a throttled answer reached the server")
+	}
+
+	// The wait is kept in the cache, so a new client honours it too.
+	c2 := New(srv.URL, session, c.CacheDir)
+	c2.now = c.now
+	if _, err := c2.Submit(ctx, 2025, 1, 1, "3"); !errors.Is(err, ErrThrottled) {
+		t.Errorf("new client: got %v, want ErrThrottled", err)
+	}
+
+	clock.now = clock.now.Add(61 * time.Second)
+	if _, err := c.Page(ctx, 2025, 1); err != nil {
+		t.Fatal(err)
+	}
+	res, err = c.Submit(ctx, 2025, 1, 1, "3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Verdict != Correct {
+		t.Errorf("got %+v, want correct", res)
+	}
+	if _, err := os.Stat(c.cachePath(2025, 1, "page.html")); err == nil {
+		t.Error("the page is still cached after a right answer")
+	}
+
+	res, err = c.Submit(ctx, 2025, 1, 2, "3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Verdict != WrongLevel {
+		t.Errorf("got %+v, want wrong level", res)
+	}
+}
+
+func TestRequestsAreSpacedOut(t *testing.T) {
+	srv := newStandIn(t)
+	c, clock := newTestClient(t, srv.URL, session)
+	c.Interval = 10 * time.Second
+	ctx := context.Background()
+
+	if _, err := c.Input(ctx, 2025, 1); err != nil {
+		t.Fatal(err)
+	}
+	clock.now = clock.now.Add(4 * time.Second)
+	if _, err := c.Page(ctx, 2025, 1); err != nil {
+		t.Fatal(err)
+	}
+	if len(clock.slept) != 1 || clock.slept[0] != 6*time.Second {
+		t.Errorf("slept %v, want [6s]", clock.slept)
+	}
+}
+
+func TestParseResult(t *testing.T) {
+	tests := []struct {
+		page string
+		want Result
+	}{
+		{
+			`<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 12s left to wait. <a href="/2025/day/1">[Return to Day 1]</a></p></article>`,
+			Result{Verdict: TooSoon, Wait: 72 * time.Second},
+		},
+		{
+			`<article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data; please wait 5 minutes before trying again.</p></article>`,
+			Result{Verdict: Incorrect, Wait: 5 * time.Minute},
+		},
+		{
+			`<article><p>That's not the right answer; your answer is too low.</p></article>`,
+			Result{Verdict: Incorrect, Hint: "too low"},
+		},
+		{`<html>maintenance</html>`, Result{Verdict: Unknown}},
+	}
+	for _, tt := range tests {
+		got := parseResult([]byte(tt.page))
+		if got.Verdict != tt.want.Verdict || got.Hint != tt.want.Hint || got.Wait != tt.want.Wait {
+			t.Errorf("%.40q: got %v %q %v, want %v %q %v", tt.page, got.Verdict, got.Hint, got.Wait, tt.want.Verdict, tt.want.Hint, tt.want.Wait)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Package dsu is a disjoint-set forest (union-find) over the elements 0 to
+// n-1, for puzzles that merge things into groups:
+//
+//	d := dsu.New(len(boxes))
+//	for _, c := range connections {
+//		if d.Union(c.a, c.b) && d.Count() == 1 {
+//			// c joined the last two groups
+//		}
+//	}
+//
+// Unions are by size. A forest made with NewWithRollback can also undo
+// unions back to a Snapshot, as offline algorithms that try a merge and
+// back out need; it gives up path compression for that, so Find takes
+// O(log n) rather than nearly constant time.
+package dsu
+
+import (
+	"slices"
+)
+
+// DSU is a disjoint-set forest. The zero value is an empty forest; use New.
+type DSU struct {
+	parent []int
+	size   []int // valid for roots only
+	count  int
+
+	rollback bool
+	history  []int // roots that were attached to another root, in order
+}
+
+// New returns a forest of n singleton sets.
+func New(n int) *DSU {
+	d := &DSU{parent: make([]int, n), size: make([]int, n), count: n}
+	for i := range d.parent {
+		d.parent[i] = i
+		d.size[i] = 1
+	}
+	return d
+}
+
+// NewWithRollback returns a forest of n singleton sets whose unions can be
+// undone with Rollback.
+func NewWithRollback(n int) *DSU {
+	d := New(n)
+	d.rollback = true
+	return d
+}
+
+// Len returns the number of elements.
+func (d *DSU) Len() int {
+	return len(d.parent)
+}
+
+// Find returns the representative of the set holding x.
+func (d *DSU) Find(x int) int {
+	root := x
+	for d.parent[root] != root {
+		root = d.parent[root]
+	}
+	if !d.rollback {
+		for d.parent[x] != root {
+			d.parent[x], x = root, d.parent[x]
+		}
+	}
+	return root
+}
+
+// Union merges the sets holding a and b and reports whether they were
+// separate.
+func (d *DSU) Union(a, b int) bool {
+	ra, rb := d.Find(a), d.Find(b)
+	if ra == rb {
+		return false
+	}
+	if d.size[ra] < d.size[rb] {
+		ra, rb = rb, ra
+	}
+	d.parent[rb] = ra
+	d.size[ra] += d.size[rb]
+	d.count--
+	if d.rollback {
+		d.history = append(d.history, rb)
+	}
+	return true
+}
+
+// Same reports whether a and b are in the same set.
+func (d *DSU) Same(a, b int) bool {
+	return d.Find(a) == d.Find(b)
+}
+
+// Size returns the number of elements in the set holding x.
+func (d *DSU) Size(x int) int {
+	return d.size[d.Find(x)]
+}
+
+// Count returns the number of sets.
+func (d *DSU) Count() int {
+	return d.count
+}
+
+// Components returns the elements of every set, each in ascending order,
+// ordered by their smallest element.
+func (d *DSU) Components() [][]int {
+	index := make(map[int]int, d.count)
+	var sets [][]int
+	for x := range d.parent {
+		root := d.Find(x)
+		i, ok := index[root]
+		if !ok {
+			i = len(sets)
+			index[root] = i
+			sets = append(sets, make([]int, 0, d.size[root]))
+		}
+		sets[i] = append(sets[i], x)
+	}
+	return sets
+}
+
+// TopSizes returns the sizes of the k largest sets, largest first. There are
+// fewer than k when the forest has fewer sets.
+func (d *DSU) TopSizes(k int) []int {
+	var sizes []int
+	for x, p := range d.parent {
+		if x == p {
+			sizes = append(sizes, d.size[x])
+		}
+	}
+	slices.SortFunc(sizes, func(a, b int) int { return b - a })
+	return sizes[:min(k, len(sizes))]
+}
+
+// Snapshot returns a point to Rollback to. It panics unless the forest was
+// made with NewWithRollback.
+func (d *DSU) Snapshot() int {
+	if !d.rollback {
+		panic("dsu: Snapshot needs a forest made with NewWithRollback")
+	}
+	return len(d.history)
+}
+
+// Rollback undoes every union since snapshot, most recent first.
+func (d *DSU) Rollback(snapshot int) {
+	if !d.rollback {
+		panic("dsu: Rollback needs a forest made with NewWithRollback")
+	}
+	for len(d.history) > snapshot {
+		rb := d.history[len(d.history)-1]
+		d.history = d.history[:len(d.history)-1]
+		ra := d.parent[rb]
+		d.parent[rb] = rb
+		d.size[ra] -= d.size[rb]
+		d.count++
+	}
+}
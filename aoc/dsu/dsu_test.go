@@ -0,0 +1,121 @@
+package dsu
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestUnion(t *testing.T) {
+	d := New(6)
+	steps := []struct {
+		a, b   int
+		merged bool
+		count  int
+	}{
+		{0, 1, true, 5},
+		{1, 0, false, 5},
+		{2, 3, true, 4},
+		{1, 3, true, 3},
+		{0, 2, false, 3},
+	}
+	for _, s := range steps {
+		if got := d.Union(s.a, s.b); got != s.merged {
+			t.Errorf("Union(%d, %d) = %v, want %v", s.a, s.b, got, s.merged)
+		}
+		if d.Count() != s.count {
+			t.Errorf("after Union(%d, %d): Count() = %d, want %d", s.a, s.b, d.Count(), s.count)
+		}
+	}
+
+	if d.Size(3) != 4 || d.Size(4) != 1 {
+		t.Errorf("sizes %d and %d, want 4 and 1", d.Size(3), d.Size(4))
+	}
+	if !d.Same(0, 3) || d.Same(0, 5) {
+		t.Error("Same disagrees with the unions")
+	}
+	want := [][]int{{0, 1, 2, 3}, {4}, {5}}
+	if got := d.Components(); !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("Components() = %v, want %v", got, want)
+	}
+	if got := d.TopSizes(2); !slices.Equal(got, []int{4, 1}) {
+		t.Errorf("TopSizes(2) = %v, want [4 1]", got)
+	}
+	if got := d.TopSizes(10); len(got) != 3 {
+		t.Errorf("TopSizes(10) = %v, want all 3 sets", got)
+	}
+}
+
+func TestRollback(t *testing.T) {
+	d := NewWithRollback(5)
+	d.Union(0, 1)
+	snap := d.Snapshot()
+	d.Union(2, 3)
+	d.Union(1, 3)
+	d.Union(0, 2) // already joined
+	if d.Count() != 2 || d.Size(0) != 4 {
+		t.Fatalf("before rollback: Count() = %d, Size(0) = %d", d.Count(), d.Size(0))
+	}
+
+	d.Rollback(snap)
+	if d.Count() != 4 || d.Size(0) != 2 || d.Size(2) != 1 || d.Same(1, 3) {
+		t.Errorf("after rollback: Count() = %d, Size(0) = %d, Size(2) = %d", d.Count(), d.Size(0), d.Size(2))
+	}
+	if !d.Same(0, 1) {
+		t.Error("rollback undid the union before the snapshot")
+	}
+}
+
+// TestAgainstLabels checks random unions and rollbacks against a plain
+// label per element.
+func TestAgainstLabels(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	const n = 50
+	for _, rollback := range []bool{false, true} {
+		d := New(n)
+		if rollback {
+			d = NewWithRollback(n)
+		}
+		label := make([]int, n)
+		for i := range label {
+			label[i] = i
+		}
+		var saved [][]int
+		var snaps []int
+		for range 500 {
+			switch op := r.IntN(10); {
+			case rollback && op == 0:
+				saved = append(saved, slices.Clone(label))
+				snaps = append(snaps, d.Snapshot())
+			case rollback && op == 1 && len(snaps) > 0:
+				d.Rollback(snaps[len(snaps)-1])
+				label = saved[len(saved)-1]
+				snaps, saved = snaps[:len(snaps)-1], saved[:len(saved)-1]
+			default:
+				a, b := r.IntN(n), r.IntN(n)
+				want := label[a] != label[b]
+				if got := d.Union(a, b); got != want {
+					t.Fatalf("rollback %v: Union(%d, %d) = %v, want %v", rollback, a, b, got, want)
+				}
+				from := label[b]
+				for i := range label {
+					if label[i] == from {
+						label[i] = label[a]
+					}
+				}
+			}
+			sets := map[int]int{}
+			for _, l := range label {
+				sets[l]++
+			}
+			if d.Count() != len(sets) {
+				t.Fatalf("rollback %v: Count() = %d, want %d", rollback, d.Count(), len(sets))
+			}
+			for x := range n {
+				if d.Size(x) != sets[label[x]] {
+					t.Fatalf("rollback %v: Size(%d) = %d, want %d", rollback, x, d.Size(x), sets[label[x]])
+				}
+			}
+		}
+	}
+}
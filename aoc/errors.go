@@ -0,0 +1,57 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ParseError reports a malformed piece of puzzle input and where it is.
+type ParseError struct {
+	File string // input file name, filled in by InFile
+	Line int    // 1-based line number
+	Col  int    // 1-based byte column; 0 when the whole line is at fault
+	Msg  string
+	Err  error // underlying error, e.g. strconv.ErrSyntax
+}
+
+func (e *ParseError) Error() string {
+	pos := fmt.Sprintf("line %d", e.Line)
+	if e.Col > 0 {
+		pos += fmt.Sprintf(", column %d", e.Col)
+	}
+	if e.File != "" {
+		pos = fmt.Sprintf("%s:%d", e.File, e.Line)
+		if e.Col > 0 {
+			pos += fmt.Sprintf(":%d", e.Col)
+		}
+	}
+
+	msg := e.Msg
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return pos + ": " + msg
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Errorf returns a ParseError for the given line and column.
+func Errorf(line, col int, format string, args ...any) error {
+	return &ParseError{Line: line, Col: col, Msg: fmt.Sprintf(format, args...)}
+}
+
+// InFile records the input file name in the ParseError wrapped by err, if
+// any, and returns err. The solvers only know lines and columns; whoever
+// opened the file knows its name. Stdin is recorded as "<stdin>".
+func InFile(err error, file string) error {
+	if file == Stdin {
+		file = "<stdin>"
+	}
+	var pe *ParseError
+	if errors.As(err, &pe) && pe.File == "" {
+		pe.File = file
+	}
+	return err
+}
@@ -0,0 +1,415 @@
+// Package geom is plane geometry on integer coordinates, mostly for
+// polygons whose corners are puzzle tiles:
+//
+//	p, err := geom.NewPolygon(corners)
+//	if p.Locate(q) != geom.Outside { ... }
+//	if p.ContainsRect(geom.RectOf(a, b)) { ... }
+//
+// Everything is exact: no float64, and orientation tests and areas work in
+// 128 bits. Polygon coordinates must lie strictly within ±Limit, which
+// NewPolygon checks, so that the doubled coordinates Locate works with and
+// their differences fit in an int64.
+package geom
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"slices"
+
+	"adventofcode/aoc/checked"
+)
+
+// Limit bounds polygon coordinates: |X| and |Y| must be below it.
+const Limit = 1 << 61
+
+// Point is a point of the integer lattice.
+type Point struct {
+	X, Y int64
+}
+
+// Sub returns p-q.
+func (p Point) Sub(q Point) Point {
+	return Point{p.X - q.X, p.Y - q.Y}
+}
+
+// Orient returns 1 if a, b, c turn counterclockwise (with Y pointing up),
+// -1 if they turn clockwise and 0 if they are collinear. It is exact for
+// any points whose differences fit in an int64.
+func Orient(a, b, c Point) int {
+	u, v := b.Sub(a), c.Sub(a)
+	return cmp128(mul128(u.X, v.Y), mul128(u.Y, v.X))
+}
+
+// int128 is a signed 128-bit integer.
+type int128 struct {
+	hi int64
+	lo uint64
+}
+
+// mul128 returns a*b.
+func mul128(a, b int64) int128 {
+	neg := (a < 0) != (b < 0)
+	ua, ub := uint64(a), uint64(b)
+	if a < 0 {
+		ua = -ua
+	}
+	if b < 0 {
+		ub = -ub
+	}
+	hi, lo := bits.Mul64(ua, ub)
+	if neg {
+		lo = -lo
+		hi = ^hi
+		if lo == 0 {
+			hi++
+		}
+	}
+	return int128{int64(hi), lo}
+}
+
+// add128 returns a+b, wrapping modulo 2^128.
+func add128(a, b int128) int128 {
+	lo, carry := bits.Add64(a.lo, b.lo, 0)
+	return int128{a.hi + b.hi + int64(carry), lo}
+}
+
+// sub128 returns a-b, wrapping modulo 2^128.
+func sub128(a, b int128) int128 {
+	lo, borrow := bits.Sub64(a.lo, b.lo, 0)
+	return int128{a.hi - b.hi - int64(borrow), lo}
+}
+
+// int64 returns a as an int64, or an error wrapping checked.ErrOverflow if
+// it does not fit.
+func (a int128) int64() (int64, error) {
+	if a.hi != int64(a.lo)>>63 {
+		return 0, fmt.Errorf("%w: %s", checked.ErrOverflow, a)
+	}
+	return int64(a.lo), nil
+}
+
+func (a int128) String() string {
+	n := new(big.Int).Lsh(big.NewInt(a.hi), 64)
+	return n.Add(n, new(big.Int).SetUint64(a.lo)).String()
+}
+
+// cmp128 returns -1, 0 or 1 as a is less than, equal to or greater than b.
+func cmp128(a, b int128) int {
+	switch {
+	case a.hi < b.hi || a.hi == b.hi && a.lo < b.lo:
+		return -1
+	case a == b:
+		return 0
+	}
+	return 1
+}
+
+// Segment is the straight line from A to B, both ends included.
+type Segment struct {
+	A, B Point
+}
+
+// Contains reports whether p lies on s.
+func (s Segment) Contains(p Point) bool {
+	return Orient(s.A, s.B, p) == 0 &&
+		min(s.A.X, s.B.X) <= p.X && p.X <= max(s.A.X, s.B.X) &&
+		min(s.A.Y, s.B.Y) <= p.Y && p.Y <= max(s.A.Y, s.B.Y)
+}
+
+// Intersects reports whether s and t have a point in common, touching and
+// overlapping included.
+func (s Segment) Intersects(t Segment) bool {
+	o1, o2 := Orient(s.A, s.B, t.A), Orient(s.A, s.B, t.B)
+	o3, o4 := Orient(t.A, t.B, s.A), Orient(t.A, t.B, s.B)
+	if o1*o2 < 0 && o3*o4 < 0 {
+		return true
+	}
+	return s.Contains(t.A) || s.Contains(t.B) || t.Contains(s.A) || t.Contains(s.B)
+}
+
+// Polygon is a closed polygon: its vertices in order, the last joined back
+// to the first. Its edges are assumed not to cross each other.
+type Polygon struct {
+	vertices    []Point
+	rectilinear bool
+}
+
+// NewPolygon returns the polygon with the given vertices. There must be at
+// least 3, no two in a row the same, all within ±Limit. It does not check
+// that the edges do not cross.
+func NewPolygon(vertices []Point) (*Polygon, error) {
+	if len(vertices) < 3 {
+		return nil, fmt.Errorf("a polygon needs at least 3 vertices, got %d", len(vertices))
+	}
+	p := &Polygon{vertices: slices.Clone(vertices), rectilinear: true}
+	for i, v := range vertices {
+		if v.X <= -Limit || v.X >= Limit || v.Y <= -Limit || v.Y >= Limit {
+			return nil, fmt.Errorf("vertex %d at %d,%d is beyond ±%d", i, v.X, v.Y, Limit)
+		}
+		next := vertices[(i+1)%len(vertices)]
+		if v == next {
+			return nil, fmt.Errorf("vertex %d repeats at %d,%d", i, v.X, v.Y)
+		}
+		if v.X != next.X && v.Y != next.Y {
+			p.rectilinear = false
+		}
+	}
+	return p, nil
+}
+
+// Vertices returns the vertices of p in order.
+func (p *Polygon) Vertices() []Point {
+	return slices.Clone(p.vertices)
+}
+
+// Edges returns the edges of p, edge i running from vertex i to the next.
+func (p *Polygon) Edges() []Segment {
+	edges := make([]Segment, len(p.vertices))
+	for i := range p.vertices {
+		edges[i] = p.edge(i)
+	}
+	return edges
+}
+
+// edge returns edge i of p.
+func (p *Polygon) edge(i int) Segment {
+	return Segment{p.vertices[i], p.vertices[(i+1)%len(p.vertices)]}
+}
+
+// Rectilinear reports whether every edge of p is horizontal or vertical.
+func (p *Polygon) Rectilinear() bool {
+	return p.rectilinear
+}
+
+// TwiceArea returns twice the signed area of p by the shoelace formula:
+// positive if its vertices run counterclockwise, negative if clockwise.
+// Twice the area is always a whole number; one too large for an int64 is
+// an error wrapping checked.ErrOverflow.
+func (p *Polygon) TwiceArea() (int64, error) {
+	return p.twiceArea().int64()
+}
+
+// twiceArea is TwiceArea in 128 bits. The partial sums may wrap, but the
+// arithmetic is modulo 2^128 and within ±Limit the total fits, so it comes
+// out right.
+func (p *Polygon) twiceArea() int128 {
+	var sum int128
+	for i := range p.vertices {
+		e := p.edge(i)
+		sum = add128(sum, sub128(mul128(e.A.X, e.B.Y), mul128(e.B.X, e.A.Y)))
+	}
+	return sum
+}
+
+// Orientation returns 1 if the vertices of p run counterclockwise and -1 if
+// they run clockwise.
+func (p *Polygon) Orientation() int {
+	if p.twiceArea().hi < 0 {
+		return -1
+	}
+	return 1
+}
+
+// BoundaryPoints returns the number of lattice points on the edges of p,
+// or an error wrapping checked.ErrOverflow if there are too many for an
+// int64.
+func (p *Polygon) BoundaryPoints() (int64, error) {
+	var n int64
+	for i := range p.vertices {
+		e := p.edge(i)
+		d := e.B.Sub(e.A)
+		var err error
+		if n, err = checked.Add(n, gcd(abs(d.X), abs(d.Y))); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+// InteriorPoints returns the number of lattice points strictly inside p,
+// by Pick's theorem: area = interior + boundary/2 - 1. Too many for an
+// int64 is an error wrapping checked.ErrOverflow.
+func (p *Polygon) InteriorPoints() (int64, error) {
+	area := p.twiceArea()
+	if area.hi < 0 {
+		area = sub128(int128{}, area)
+	}
+	b, err := p.BoundaryPoints()
+	if err != nil {
+		return 0, err
+	}
+	twice := sub128(add128(area, int128{lo: 2}), int128{lo: uint64(b)})
+	return int128{twice.hi >> 1, twice.lo>>1 | uint64(twice.hi)<<63}.int64()
+}
+
+// Location is where a point lies relative to a polygon.
+type Location int
+
+const (
+	Outside Location = iota
+	Boundary
+	Inside
+)
+
+func (l Location) String() string {
+	switch l {
+	case Outside:
+		return "outside"
+	case Boundary:
+		return "boundary"
+	case Inside:
+		return "inside"
+	}
+	return fmt.Sprintf("Location(%d)", int(l))
+}
+
+// Locate returns whether q is inside p, on its boundary or outside it.
+func (p *Polygon) Locate(q Point) Location {
+	return p.locate2(Point{2 * q.X, 2 * q.Y})
+}
+
+// locate2 is Locate for the point q/2, so that it can test the midpoints
+// between lattice points.
+func (p *Polygon) locate2(q Point) Location {
+	inside := false
+	for i := range p.vertices {
+		e := p.edge(i)
+		a, b := Point{2 * e.A.X, 2 * e.A.Y}, Point{2 * e.B.X, 2 * e.B.Y}
+		if (Segment{a, b}).Contains(q) {
+			return Boundary
+		}
+		// Count the edges crossing the ray from q to the right.
+		if (a.Y > q.Y) != (b.Y > q.Y) {
+			if o := Orient(a, b, q); (o > 0) == (b.Y > a.Y) {
+				inside = !inside
+			}
+		}
+	}
+	if inside {
+		return Inside
+	}
+	return Outside
+}
+
+// Rect is the axis-aligned rectangle with corners Min and Max, edges
+// included.
+type Rect struct {
+	Min, Max Point
+}
+
+// RectOf returns the rectangle with opposite corners a and b.
+func RectOf(a, b Point) Rect {
+	return Rect{Point{min(a.X, b.X), min(a.Y, b.Y)}, Point{max(a.X, b.X), max(a.Y, b.Y)}}
+}
+
+// Points returns the number of lattice points in r, edges included. r need
+// not be within ±Limit; a count too large for an int64 is an error wrapping
+// checked.ErrOverflow.
+func (r Rect) Points() (int64, error) {
+	side := func(lo, hi int64) (int64, error) {
+		n, err := checked.Sub(hi, lo)
+		if err != nil {
+			return 0, err
+		}
+		return checked.Add(n, 1)
+	}
+	w, err := side(r.Min.X, r.Max.X)
+	if err != nil {
+		return 0, err
+	}
+	h, err := side(r.Min.Y, r.Max.Y)
+	if err != nil {
+		return 0, err
+	}
+	return checked.Mul(w, h)
+}
+
+// ContainsRect reports whether all of r lies inside p or on its boundary.
+// r must lie within ±Limit, as p does. It panics unless p is rectilinear.
+func (p *Polygon) ContainsRect(r Rect) bool {
+	if !p.rectilinear {
+		panic("geom: ContainsRect needs a rectilinear polygon")
+	}
+	if r.Min.X == r.Max.X || r.Min.Y == r.Max.Y {
+		return p.containsSegment(r)
+	}
+	// If no edge meets the open inside of r, that is all inside p or all
+	// outside it, and its centre tells which; its edges then follow.
+	for i := range p.vertices {
+		e := p.edge(i)
+		b := RectOf(e.A, e.B)
+		if e.A.X == e.B.X {
+			if r.Min.X < e.A.X && e.A.X < r.Max.X && max(r.Min.Y, b.Min.Y) < min(r.Max.Y, b.Max.Y) {
+				return false
+			}
+		} else if r.Min.Y < e.A.Y && e.A.Y < r.Max.Y && max(r.Min.X, b.Min.X) < min(r.Max.X, b.Max.X) {
+			return false
+		}
+	}
+	return p.locate2(Point{r.Min.X + r.Max.X, r.Min.Y + r.Max.Y}) == Inside
+}
+
+// containsSegment is ContainsRect for a rectangle of zero width or height,
+// which is a segment or a point. Cut at every vertex coordinate along it,
+// each open piece is all on one side of the boundary, so its midpoint and
+// the cut points decide.
+func (p *Polygon) containsSegment(r Rect) bool {
+	// along gives a point's coordinate along the segment, and at the point
+	// at twice a coordinate along it, twice over for locate2.
+	along := func(q Point) int64 { return q.X }
+	at := func(v2 int64) Point { return Point{v2, 2 * r.Min.Y} }
+	if r.Min.X == r.Max.X {
+		along = func(q Point) int64 { return q.Y }
+		at = func(v2 int64) Point { return Point{2 * r.Min.X, v2} }
+	}
+	cuts := []int64{along(r.Min), along(r.Max)}
+	for _, v := range p.vertices {
+		if c := along(v); along(r.Min) < c && c < along(r.Max) {
+			cuts = append(cuts, c)
+		}
+	}
+	axis := NewAxis(cuts...)
+	for i, c := range axis {
+		if p.locate2(at(2*c)) == Outside {
+			return false
+		}
+		if i > 0 && p.locate2(at(axis[i-1]+c)) == Outside {
+			return false
+		}
+	}
+	return true
+}
+
+// Axis is a sorted list of distinct coordinates, for compressing a sparse
+// plane onto a small grid: coordinate v maps to its index.
+type Axis []int64
+
+// NewAxis returns the distinct values in ascending order.
+func NewAxis(values ...int64) Axis {
+	a := slices.Clone(values)
+	slices.Sort(a)
+	return slices.Compact(a)
+}
+
+// Index returns the index of v in a, and whether v is there at all; if not,
+// the index is where it would go.
+func (a Axis) Index(v int64) (int, bool) {
+	return slices.BinarySearch(a, v)
+}
+
+// gcd returns the greatest common divisor of a and b, which must not be
+// negative; gcd(0, 0) is 0.
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
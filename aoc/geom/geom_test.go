@@ -0,0 +1,248 @@
+package geom
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"adventofcode/aoc/checked"
+)
+
+func mustPolygon(t *testing.T, vertices ...Point) *Polygon {
+	t.Helper()
+	p, err := NewPolygon(vertices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// u is a U shape 6 wide and 5 high with a notch 2 wide and 3 deep in the
+// top, clockwise with Y pointing up.
+var u = []Point{{0, 0}, {0, 5}, {2, 5}, {2, 2}, {4, 2}, {4, 5}, {6, 5}, {6, 0}}
+
+func TestAreaAndPick(t *testing.T) {
+	tests := []struct {
+		name                          string
+		vertices                      []Point
+		twiceArea, boundary, interior int64
+	}{
+		{"u", u, -48, 28, 11},
+		{"triangle", []Point{{0, 0}, {4, 0}, {0, 4}}, 16, 12, 3},
+		{"slanted", []Point{{0, 0}, {3, 1}, {1, 3}}, 8, 4, 3},
+	}
+	for _, tt := range tests {
+		p := mustPolygon(t, tt.vertices...)
+		if got, err := p.TwiceArea(); got != tt.twiceArea || err != nil {
+			t.Errorf("%s: TwiceArea() = %d, %v, want %d", tt.name, got, err, tt.twiceArea)
+		}
+		if got, err := p.BoundaryPoints(); got != tt.boundary || err != nil {
+			t.Errorf("%s: BoundaryPoints() = %d, %v, want %d", tt.name, got, err, tt.boundary)
+		}
+		if got, err := p.InteriorPoints(); got != tt.interior || err != nil {
+			t.Errorf("%s: InteriorPoints() = %d, %v, want %d", tt.name, got, err, tt.interior)
+		}
+
+		// Pick's theorem against counting the lattice points one by one.
+		var in, on int64
+		for x := int64(-1); x <= 7; x++ {
+			for y := int64(-1); y <= 7; y++ {
+				switch p.Locate(Point{x, y}) {
+				case Inside:
+					in++
+				case Boundary:
+					on++
+				}
+			}
+		}
+		if in != tt.interior || on != tt.boundary {
+			t.Errorf("%s: Locate finds %d inside and %d on the boundary", tt.name, in, on)
+		}
+	}
+
+	p := mustPolygon(t, u...)
+	if p.Orientation() != -1 || !p.Rectilinear() {
+		t.Errorf("u: Orientation() = %d, Rectilinear() = %v", p.Orientation(), p.Rectilinear())
+	}
+	if mustPolygon(t, Point{0, 0}, Point{4, 0}, Point{0, 4}).Rectilinear() {
+		t.Error("a triangle is rectilinear")
+	}
+}
+
+func TestLocate(t *testing.T) {
+	p := mustPolygon(t, u...)
+	for q, want := range map[Point]Location{
+		{1, 1}: Inside, {3, 1}: Inside, {5, 4}: Inside,
+		{3, 3}: Outside, {3, 5}: Outside, {7, 1}: Outside, {-1, 0}: Outside,
+		{0, 0}: Boundary, {3, 2}: Boundary, {2, 4}: Boundary, {6, 3}: Boundary,
+	} {
+		if got := p.Locate(q); got != want {
+			t.Errorf("Locate(%v) = %v, want %v", q, got, want)
+		}
+	}
+}
+
+func TestNewPolygonErrors(t *testing.T) {
+	for _, vertices := range [][]Point{
+		{{0, 0}, {1, 0}},
+		{{0, 0}, {1, 0}, {1, 0}, {0, 1}},
+		{{0, 0}, {1, 0}, {0, 1}, {0, 0}},
+		{{0, 0}, {Limit, 0}, {0, 1}},
+	} {
+		if _, err := NewPolygon(vertices); err == nil {
+			t.Errorf("NewPolygon(%v) gave no error", vertices)
+		}
+	}
+}
+
+func TestIntersects(t *testing.T) {
+	tests := []struct {
+		name string
+		s, t Segment
+		want bool
+	}{
+		{"crossing", Segment{Point{0, 0}, Point{4, 4}}, Segment{Point{0, 4}, Point{4, 0}}, true},
+		{"touching", Segment{Point{0, 0}, Point{4, 0}}, Segment{Point{2, 0}, Point{2, 3}}, true},
+		{"sharing an end", Segment{Point{0, 0}, Point{1, 1}}, Segment{Point{1, 1}, Point{2, 0}}, true},
+		{"overlapping", Segment{Point{0, 0}, Point{3, 0}}, Segment{Point{2, 0}, Point{5, 0}}, true},
+		{"collinear apart", Segment{Point{0, 0}, Point{1, 0}}, Segment{Point{2, 0}, Point{3, 0}}, false},
+		{"parallel", Segment{Point{0, 0}, Point{3, 0}}, Segment{Point{0, 1}, Point{3, 1}}, false},
+		{"short of each other", Segment{Point{0, 0}, Point{2, 2}}, Segment{Point{3, 0}, Point{2, 1}}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.s.Intersects(tt.t); got != tt.want {
+			t.Errorf("%s: got %v", tt.name, got)
+		}
+		if got := tt.t.Intersects(tt.s); got != tt.want {
+			t.Errorf("%s reversed: got %v", tt.name, got)
+		}
+	}
+}
+
+func TestOrientIsExact(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	coord := func() int64 { return r.Int64N(1<<62) - 1<<61 }
+	for range 10000 {
+		a, b, c := Point{coord(), coord()}, Point{coord(), coord()}, Point{coord(), coord()}
+		if r.IntN(4) == 0 { // collinear
+			c = Point{2*b.X - a.X, 2*b.Y - a.Y}
+		}
+		u, v := b.Sub(a), c.Sub(a)
+		want := new(big.Int).Sub(
+			new(big.Int).Mul(big.NewInt(u.X), big.NewInt(v.Y)),
+			new(big.Int).Mul(big.NewInt(u.Y), big.NewInt(v.X)),
+		).Sign()
+		if got := Orient(a, b, c); got != want {
+			t.Fatalf("Orient(%v, %v, %v) = %d, want %d", a, b, c, got, want)
+		}
+	}
+}
+
+// TestContainsRect checks every rectangle with corners around the U shape
+// against locating every point and midpoint in it.
+func TestContainsRect(t *testing.T) {
+	p := mustPolygon(t, u...)
+	for x0 := int64(-1); x0 <= 7; x0++ {
+		for y0 := int64(-1); y0 <= 6; y0++ {
+			for x1 := x0; x1 <= 7; x1++ {
+				for y1 := y0; y1 <= 6; y1++ {
+					r := Rect{Point{x0, y0}, Point{x1, y1}}
+					want := true
+					for x := 2 * x0; x <= 2*x1 && want; x++ {
+						for y := 2 * y0; y <= 2*y1 && want; y++ {
+							want = p.locate2(Point{x, y}) != Outside
+						}
+					}
+					if got := p.ContainsRect(r); got != want {
+						t.Errorf("ContainsRect(%v) = %v, want %v", r, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestNearLimit scales u up until its corners nearly reach ±Limit: Locate
+// and ContainsRect must agree with the small u, and the area must come out
+// exact or as an overflow.
+func TestNearLimit(t *testing.T) {
+	const k = (Limit - 1) / 3
+	scale := func(q Point) Point { return Point{(q.X - 3) * k, (q.Y - 3) * k} }
+	small := mustPolygon(t, u...)
+	var corners []Point
+	for _, v := range u {
+		corners = append(corners, scale(v))
+	}
+	p := mustPolygon(t, corners...)
+
+	want := new(big.Int).Mul(big.NewInt(-48), new(big.Int).Mul(big.NewInt(k), big.NewInt(k)))
+	if got := p.twiceArea().String(); got != want.String() {
+		t.Errorf("twiceArea() = %s, want %s", got, want)
+	}
+	if _, err := p.TwiceArea(); !errors.Is(err, checked.ErrOverflow) {
+		t.Errorf("TwiceArea() gave %v, want an overflow", err)
+	}
+	if _, err := p.InteriorPoints(); !errors.Is(err, checked.ErrOverflow) {
+		t.Errorf("InteriorPoints() gave %v, want an overflow", err)
+	}
+	if p.Orientation() != -1 {
+		t.Errorf("Orientation() = %d", p.Orientation())
+	}
+
+	// Points and rectangles must be within ±Limit too, so stay within u.
+	for x := int64(0); x <= 6; x++ {
+		for y := int64(0); y <= 5; y++ {
+			q := Point{x, y}
+			if got, want := p.Locate(scale(q)), small.Locate(q); got != want {
+				t.Errorf("Locate(%v) = %v, want %v", scale(q), got, want)
+			}
+			for _, r := range []Rect{{Point{0, 0}, q}, {q, Point{6, 5}}, {Point{x, 0}, Point{x, y}}} {
+				r = RectOf(r.Min, r.Max)
+				scaled := Rect{scale(r.Min), scale(r.Max)}
+				if got, want := p.ContainsRect(scaled), small.ContainsRect(r); got != want {
+					t.Errorf("ContainsRect(%v) = %v, want %v", scaled, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestRectPoints(t *testing.T) {
+	tests := []struct {
+		a, b     Point
+		want     int64
+		overflow bool
+	}{
+		{Point{2, 5}, Point{11, 1}, 50, false},
+		{Point{3, 3}, Point{3, 3}, 1, false},
+		{Point{0, 0}, Point{3037000498, 3037000498}, 3037000499 * 3037000499, false},
+		{Point{0, 0}, Point{4000000000, 4000000000}, 0, true},
+		{Point{math.MinInt64, 0}, Point{math.MaxInt64, 0}, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := RectOf(tt.a, tt.b).Points()
+		if tt.overflow {
+			if !errors.Is(err, checked.ErrOverflow) {
+				t.Errorf("Points of %v-%v = %d, %v, want an overflow", tt.a, tt.b, got, err)
+			}
+		} else if err != nil || got != tt.want {
+			t.Errorf("Points of %v-%v = %d, %v, want %d", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+}
+
+func TestAxis(t *testing.T) {
+	a := NewAxis(30, 10, 20, 10)
+	if !slices.Equal(a, Axis{10, 20, 30}) {
+		t.Fatalf("NewAxis = %v", a)
+	}
+	if i, ok := a.Index(20); i != 1 || !ok {
+		t.Errorf("Index(20) = %d, %v", i, ok)
+	}
+	if i, ok := a.Index(25); i != 2 || ok {
+		t.Errorf("Index(25) = %d, %v", i, ok)
+	}
+}
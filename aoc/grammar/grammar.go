@@ -0,0 +1,198 @@
+package grammar
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"adventofcode/aoc"
+)
+
+// Block checks a group of consecutive lines, the whole input or one of its
+// blank line separated sections.
+type Block interface {
+	// check appends a *aoc.ParseError to errs for every deviation. first is
+	// the line number of lines[0].
+	check(lines []string, first int, errs *[]error)
+}
+
+// Line checks a single line against rule, which must match all of it, and
+// returns the deviation if any.
+func Line(rule Rule, line string, lineNo int) error {
+	m := &matcher{line: line}
+	end, ok := rule.match(m, 0)
+	if ok && end == len(line) {
+		return nil
+	}
+	if ok {
+		m.fail(end, "end of line")
+	}
+	return aoc.Errorf(lineNo, m.far+1, "expected %s, got %s", m.expected(), m.got())
+}
+
+type each struct {
+	rule Rule
+}
+
+// Each checks every line against rule.
+func Each(rule Rule) Block {
+	return each{rule}
+}
+
+func (b each) check(lines []string, first int, errs *[]error) {
+	for i, line := range lines {
+		if err := Line(b.rule, line, first+i); err != nil {
+			*errs = append(*errs, err)
+		}
+	}
+}
+
+type header struct {
+	head Rule
+	body Block
+}
+
+// Header checks the first line against head and the others against body,
+// e.g. the "0:" above a day12 shape.
+func Header(head Rule, body Block) Block {
+	return header{head, body}
+}
+
+func (b header) check(lines []string, first int, errs *[]error) {
+	if len(lines) == 0 {
+		return
+	}
+	if err := Line(b.head, lines[0], first); err != nil {
+		*errs = append(*errs, err)
+	}
+	b.body.check(lines[1:], first+1, errs)
+}
+
+type footer struct {
+	body Block
+	foot Rule
+}
+
+// Footer checks the last line against foot and the others against body,
+// e.g. the operators under day06's numbers.
+func Footer(body Block, foot Rule) Block {
+	return footer{body, foot}
+}
+
+func (b footer) check(lines []string, first int, errs *[]error) {
+	if len(lines) == 0 {
+		return
+	}
+	last := len(lines) - 1
+	b.body.check(lines[:last], first, errs)
+	if err := Line(b.foot, lines[last], first+last); err != nil {
+		*errs = append(*errs, err)
+	}
+}
+
+type sameWidth struct {
+	body Block
+}
+
+// SameWidth checks lines against body and reports every line whose length
+// differs from the first, for inputs that are read column by column.
+func SameWidth(body Block) Block {
+	return sameWidth{body}
+}
+
+func (b sameWidth) check(lines []string, first int, errs *[]error) {
+	b.body.check(lines, first, errs)
+	for i, line := range lines {
+		if len(line) != len(lines[0]) {
+			*errs = append(*errs, aoc.Errorf(first+i, 0, "line is %d bytes wide, expected %d like line %d", len(line), len(lines[0]), first))
+		}
+	}
+}
+
+type height struct {
+	n    int
+	body Block
+}
+
+// Height checks lines against body and that there are exactly n of them.
+func Height(n int, body Block) Block {
+	return height{n, body}
+}
+
+func (b height) check(lines []string, first int, errs *[]error) {
+	b.body.check(lines, first, errs)
+	if len(lines) != b.n {
+		*errs = append(*errs, aoc.Errorf(first, 0, "expected %d lines, got %d", b.n, len(lines)))
+	}
+}
+
+// Grammar is the declared shape of a whole input.
+type Grammar struct {
+	blocks   []Block
+	sections bool
+}
+
+// Lines returns a grammar checking every line of an input with body.
+// Trailing blank lines are ignored.
+func Lines(body Block) *Grammar {
+	return &Grammar{blocks: []Block{body}}
+}
+
+// Sections returns a grammar for an input of len(blocks) blank line
+// separated sections, each checked with the matching block.
+func Sections(blocks ...Block) *Grammar {
+	return &Grammar{blocks: blocks, sections: true}
+}
+
+// Check reads an input and returns every deviation from g in input order.
+// It has the signature of aoc.Puzzle's Validate.
+func (g *Grammar) Check(r io.Reader) []error {
+	var errs []error
+	if !g.sections {
+		lines, err := aoc.Lines(r)
+		if err != nil {
+			return []error{err}
+		}
+		for len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+			lines = lines[:len(lines)-1]
+		}
+		g.blocks[0].check(lines, 1, &errs)
+		return sortErrors(errs)
+	}
+
+	sections, err := aoc.Sections(r)
+	if err != nil {
+		return []error{err}
+	}
+	for i, s := range sections {
+		if i == len(g.blocks) {
+			errs = append(errs, aoc.Errorf(s.Line, 0, "expected %d sections, got %d", len(g.blocks), len(sections)))
+			break
+		}
+		g.blocks[i].check(s.Lines, s.Line, &errs)
+	}
+	if len(sections) < len(g.blocks) {
+		errs = append(errs, fmt.Errorf("expected %d sections, got %d", len(g.blocks), len(sections)))
+	}
+	return sortErrors(errs)
+}
+
+// sortErrors orders errs by position. Blocks such as SameWidth report after
+// the lines they wrap, and errors without a position go last.
+func sortErrors(errs []error) []error {
+	pos := func(err error) (int, int) {
+		var pe *aoc.ParseError
+		if errors.As(err, &pe) {
+			return pe.Line, pe.Col
+		}
+		return math.MaxInt, 0
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		li, ci := pos(errs[i])
+		lj, cj := pos(errs[j])
+		return li < lj || li == lj && ci < cj
+	})
+	return errs
+}
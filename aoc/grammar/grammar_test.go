@@ -0,0 +1,64 @@
+package grammar
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLine(t *testing.T) {
+	rotation := Seq(OneOf("LR"), Uint())
+	ranges := List(Seq(Uint(), Lit("-"), Uint()), Lit(","))
+	button := Seq(Lit("("), List(Uint(), Lit(",")), Lit(")"))
+	machine := Seq(Lit("["), Chars(".#"), Lit("]"), Spaces(), List(button, Spaces()), Spaces(), Lit("{"), List(Uint(), Lit(",")), Lit("}"))
+
+	tests := []struct {
+		rule Rule
+		line string
+		want string // empty when the line matches
+	}{
+		{rotation, "L68", ""},
+		{rotation, "X5", `line 1, column 1: expected one of "LR", got "X5"`},
+		{rotation, "R1x", `line 1, column 3: expected digit or end of line, got "x"`},
+		{rotation, "R", `line 1, column 2: expected integer, got end of line`},
+		{ranges, "11-22,95-115", ""},
+		{ranges, "11-22,3a-5", `line 1, column 8: expected digit or "-", got "a"`},
+		{ranges, "11-22,99999999999999999999-1", `line 1, column 7: expected integer that fits in 64 bits, got "99999999999999999999"`},
+		{machine, "[.##.] (3) (1,3) (2) {3,5,4,7}", ""},
+		{machine, "[.##.] (3) (1,a) {3,5,4,7}", `line 1, column 15: expected integer, got "a"`},
+		{machine, "[.##.] (3) {3,5", `line 1, column 16: expected "," or "}", got end of line`},
+		{Text(), "", ""},
+		{Text(), "anything \t at all", ""},
+		{Seq(Lit("#"), Text()), "#1 @ 3,2", ""},
+		{Seq(Lit("#"), Text()), "1", `line 1, column 1: expected "#", got "1"`},
+	}
+	for _, tt := range tests {
+		err := Line(tt.rule, tt.line, 1)
+		switch {
+		case err == nil && tt.want != "":
+			t.Errorf("%q: no error, want %s", tt.line, tt.want)
+		case err != nil && err.Error() != tt.want:
+			t.Errorf("%q: got %v, want %s", tt.line, err, tt.want)
+		}
+	}
+}
+
+func TestCheckReportsEveryDeviation(t *testing.T) {
+	g := Sections(
+		Each(Seq(Uint(), Lit("-"), Uint())),
+		SameWidth(Footer(Each(Digits()), Chars("+*"))),
+	)
+	input := "3-5\n10-x\n\n12\n3a\n456\n+*\n"
+
+	var got []string
+	for _, err := range g.Check(strings.NewReader(input)) {
+		got = append(got, err.Error())
+	}
+	want := []string{
+		`line 2, column 4: expected integer, got "x"`,
+		`line 5, column 2: expected digit or end of line, got "a"`,
+		`line 6: line is 3 bytes wide, expected 2 like line 4`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
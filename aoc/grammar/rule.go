@@ -0,0 +1,281 @@
+// Package grammar declares the shape of a day's input so that it can be
+// checked, and every deviation reported with its line and column, before a
+// solver indexes into it. A day builds its grammar from line rules, e.g. a
+// dial rotation of day01:
+//
+//	var inputGrammar = grammar.Lines(grammar.Each(grammar.Seq(grammar.OneOf("LR"), grammar.Uint())))
+//
+// and registers inputGrammar.Check as the puzzle's Validate function.
+package grammar
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Rule matches a piece of an input line.
+type Rule interface {
+	// match matches the rule at byte offset pos of m.line and returns the
+	// offset after the match.
+	match(m *matcher, pos int) (int, bool)
+}
+
+// matcher holds one line being matched. Failures are tracked at the
+// furthest offset any rule reached, which is where the line really goes
+// wrong: a list that stops early fails on whatever follows it, but the
+// element it stopped at got further.
+type matcher struct {
+	line string
+	far  int
+	want []string
+}
+
+func (m *matcher) fail(pos int, want string) (int, bool) {
+	if pos > m.far {
+		m.far = pos
+		m.want = m.want[:0]
+	}
+	if pos == m.far {
+		for _, w := range m.want {
+			if w == want {
+				return pos, false
+			}
+		}
+		m.want = append(m.want, want)
+	}
+	return pos, false
+}
+
+// got describes the input at the furthest failure for an error message.
+func (m *matcher) got() string {
+	rest := m.line[m.far:]
+	if rest == "" {
+		return "end of line"
+	}
+	n := 0
+	for n < len(rest) && n < 20 && isAlnum(rest[n]) {
+		n++
+	}
+	if n == 0 {
+		return strconv.QuoteRune(rune(rest[0]))
+	}
+	return strconv.Quote(rest[:n])
+}
+
+func (m *matcher) expected() string {
+	switch len(m.want) {
+	case 0:
+		return "nothing"
+	case 1:
+		return m.want[0]
+	}
+	return strings.Join(m.want[:len(m.want)-1], ", ") + " or " + m.want[len(m.want)-1]
+}
+
+func isAlnum(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+type lit string
+
+// Lit matches text exactly.
+func Lit(text string) Rule {
+	return lit(text)
+}
+
+func (r lit) match(m *matcher, pos int) (int, bool) {
+	if strings.HasPrefix(m.line[pos:], string(r)) {
+		return pos + len(r), true
+	}
+	return m.fail(pos, strconv.Quote(string(r)))
+}
+
+type chars struct {
+	set  string
+	name string
+	many bool
+}
+
+// OneOf matches a single byte of set.
+func OneOf(set string) Rule {
+	return chars{set: set, name: setName(set)}
+}
+
+// Chars matches a run of one or more bytes of set.
+func Chars(set string) Rule {
+	return chars{set: set, name: setName(set), many: true}
+}
+
+// Digits matches a run of decimal digits, e.g. a bank of batteries.
+func Digits() Rule {
+	return chars{set: "0123456789", name: "digit", many: true}
+}
+
+// Word matches a run of lower case letters, e.g. a device name.
+func Word() Rule {
+	return chars{set: "abcdefghijklmnopqrstuvwxyz", name: "lower case letter", many: true}
+}
+
+// Spaces matches a run of one or more spaces.
+func Spaces() Rule {
+	return chars{set: " ", name: "space", many: true}
+}
+
+type text struct{}
+
+// Text matches the rest of the line, whatever it holds, empty included. A
+// new day's grammar starts as Lines(Each(Text())) until its format is known.
+func Text() Rule {
+	return text{}
+}
+
+func (text) match(m *matcher, pos int) (int, bool) {
+	return len(m.line), true
+}
+
+func setName(set string) string {
+	if len(set) == 1 {
+		return strconv.QuoteRune(rune(set[0]))
+	}
+	return "one of " + strconv.Quote(set)
+}
+
+func (r chars) match(m *matcher, pos int) (int, bool) {
+	end := pos
+	for end < len(m.line) && strings.IndexByte(r.set, m.line[end]) >= 0 {
+		end++
+		if !r.many {
+			break
+		}
+	}
+	if end == pos {
+		return m.fail(pos, r.name)
+	}
+	if r.many && end < len(m.line) {
+		// Record that the run could have gone on, so that a bad byte after
+		// it is reported as "expected digit or ..." rather than only what
+		// follows the run.
+		m.fail(end, r.name)
+	}
+	return end, true
+}
+
+type integer struct {
+	signed bool
+}
+
+// Int matches a decimal integer that fits in an int64, with an optional
+// leading minus sign.
+func Int() Rule {
+	return integer{signed: true}
+}
+
+// Uint matches a decimal integer that fits in a uint64.
+func Uint() Rule {
+	return integer{}
+}
+
+func (r integer) match(m *matcher, pos int) (int, bool) {
+	end := pos
+	if r.signed && end < len(m.line) && m.line[end] == '-' {
+		end++
+	}
+	digits := end
+	for end < len(m.line) && m.line[end] >= '0' && m.line[end] <= '9' {
+		end++
+	}
+	if end == digits {
+		return m.fail(pos, "integer")
+	}
+
+	var err error
+	if r.signed {
+		_, err = strconv.ParseInt(m.line[pos:end], 10, 64)
+	} else {
+		_, err = strconv.ParseUint(m.line[pos:end], 10, 64)
+	}
+	if err != nil {
+		return m.fail(pos, "integer that fits in 64 bits")
+	}
+	if end < len(m.line) {
+		m.fail(end, "digit")
+	}
+	return end, true
+}
+
+type seq []Rule
+
+// Seq matches rules one after the other.
+func Seq(rules ...Rule) Rule {
+	return seq(rules)
+}
+
+func (r seq) match(m *matcher, pos int) (int, bool) {
+	for _, rule := range r {
+		var ok bool
+		if pos, ok = rule.match(m, pos); !ok {
+			return pos, false
+		}
+	}
+	return pos, true
+}
+
+type alt []Rule
+
+// Alt matches the first of rules that matches.
+func Alt(rules ...Rule) Rule {
+	return alt(rules)
+}
+
+func (r alt) match(m *matcher, pos int) (int, bool) {
+	for _, rule := range r {
+		if end, ok := rule.match(m, pos); ok {
+			return end, true
+		}
+	}
+	return pos, false
+}
+
+type opt struct {
+	rule Rule
+}
+
+// Opt matches rule or nothing.
+func Opt(rule Rule) Rule {
+	return opt{rule}
+}
+
+func (r opt) match(m *matcher, pos int) (int, bool) {
+	if end, ok := r.rule.match(m, pos); ok {
+		return end, true
+	}
+	return pos, true
+}
+
+type list struct {
+	elem, sep Rule
+}
+
+// List matches one or more elem separated by sep, e.g. List(Uint(), Lit(","))
+// for "1,3,5". A separator that is not followed by an element is left for
+// the next rule.
+func List(elem, sep Rule) Rule {
+	return list{elem, sep}
+}
+
+func (r list) match(m *matcher, pos int) (int, bool) {
+	pos, ok := r.elem.match(m, pos)
+	if !ok {
+		return pos, false
+	}
+	for {
+		next, ok := r.sep.match(m, pos)
+		if !ok {
+			return pos, true
+		}
+		if next, ok = r.elem.match(m, next); !ok {
+			return pos, true
+		}
+		pos = next
+	}
+}
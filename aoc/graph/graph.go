@@ -0,0 +1,317 @@
+// Package graph is a directed graph over named nodes, as puzzles list them
+// one per line with the nodes they lead to:
+//
+//	aaa: you hhh
+//	you: bbb ccc
+//
+// Names are interned to dense IDs, so per-node state fits in slices.
+package graph
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"adventofcode/aoc"
+)
+
+// ID is a node of a Graph, numbered from 0 in order of first mention.
+type ID int
+
+// Graph is a directed graph. The zero value is an empty graph.
+type Graph struct {
+	names    []string
+	ids      map[string]ID
+	out      [][]ID
+	declared []bool
+	lines    []ID // declared nodes in the order of their lines
+}
+
+// Node returns the ID of the node with the given name, adding the node if
+// it is new.
+func (g *Graph) Node(name string) ID {
+	if id, ok := g.ids[name]; ok {
+		return id
+	}
+	if g.ids == nil {
+		g.ids = map[string]ID{}
+	}
+	id := ID(len(g.names))
+	g.ids[name] = id
+	g.names = append(g.names, name)
+	g.out = append(g.out, nil)
+	g.declared = append(g.declared, false)
+	return id
+}
+
+// Lookup returns the ID of the named node, if the graph has it.
+func (g *Graph) Lookup(name string) (ID, bool) {
+	id, ok := g.ids[name]
+	return id, ok
+}
+
+// Name returns the name of id.
+func (g *Graph) Name(id ID) string {
+	return g.names[id]
+}
+
+// Len returns the number of nodes.
+func (g *Graph) Len() int {
+	return len(g.names)
+}
+
+// AddEdge adds an edge from one node to another.
+func (g *Graph) AddEdge(from, to ID) {
+	g.out[from] = append(g.out[from], to)
+}
+
+// Out returns the nodes id has an edge to, in the order they were added.
+func (g *Graph) Out(id ID) []ID {
+	return g.out[id]
+}
+
+// Declared reports whether id had a line of its own in the parsed input,
+// rather than only appearing as another node's target.
+func (g *Graph) Declared(id ID) bool {
+	return g.declared[id]
+}
+
+// Parse builds a graph from lines of the form "name: a b c", each giving a
+// node and the nodes it has an edge to. A node may have a line only once.
+func Parse(lines []string) (*Graph, error) {
+	g := &Graph{}
+	for i, line := range lines {
+		name, targets, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, aoc.Errorf(i+1, 0, "expected name: targets, got %q", line)
+		}
+		if name = strings.TrimSpace(name); name == "" {
+			return nil, aoc.Errorf(i+1, 1, "missing node name")
+		}
+		from := g.Node(name)
+		if g.declared[from] {
+			return nil, aoc.Errorf(i+1, 1, "node %q already has a line", name)
+		}
+		g.declared[from] = true
+		g.lines = append(g.lines, from)
+		for _, t := range strings.Fields(targets) {
+			g.AddEdge(from, g.Node(t))
+		}
+	}
+	return g, nil
+}
+
+// CycleError reports a cycle where the graph must not have one.
+type CycleError struct {
+	Cycle []string // the nodes in order, the first repeated at the end
+}
+
+func (e *CycleError) Error() string {
+	return "cycle " + strings.Join(e.Cycle, " -> ")
+}
+
+// Node states of a depth-first search.
+const (
+	unvisited = iota
+	onPath
+	finished
+)
+
+// dfs visits the nodes reachable from start depth first and calls post on
+// each once all its targets are done. It stops at the first edge back to a
+// node on the current path and returns the cycle.
+func (g *Graph) dfs(start ID, state []int8, post func(ID)) *CycleError {
+	type frame struct {
+		id   ID
+		next int
+	}
+	stack := []frame{{start, 0}}
+	state[start] = onPath
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.next == len(g.out[top.id]) {
+			state[top.id] = finished
+			post(top.id)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		to := g.out[top.id][top.next]
+		top.next++
+		switch state[to] {
+		case unvisited:
+			state[to] = onPath
+			stack = append(stack, frame{to, 0})
+		case onPath:
+			var cycle []string
+			for i := len(stack) - 1; ; i-- {
+				cycle = append(cycle, g.names[stack[i].id])
+				if stack[i].id == to {
+					break
+				}
+			}
+			slices.Reverse(cycle)
+			return &CycleError{Cycle: append(cycle, g.names[to])}
+		}
+	}
+	return nil
+}
+
+// TopoSort returns every node ordered so that each edge leads forward, or a
+// *CycleError if the graph has a cycle.
+func (g *Graph) TopoSort() ([]ID, error) {
+	state := make([]int8, g.Len())
+	order := make([]ID, 0, g.Len())
+	for id := range ID(g.Len()) {
+		if state[id] != unvisited {
+			continue
+		}
+		if err := g.dfs(id, state, func(id ID) { order = append(order, id) }); err != nil {
+			return nil, err
+		}
+	}
+	slices.Reverse(order)
+	return order, nil
+}
+
+// FindCycle returns a cycle of the graph, or nil if it has none.
+func (g *Graph) FindCycle() *CycleError {
+	_, err := g.TopoSort()
+	if err != nil {
+		return err.(*CycleError)
+	}
+	return nil
+}
+
+// Reachable returns which nodes can be reached from start, start included,
+// indexed by ID.
+func (g *Graph) Reachable(start ID) []bool {
+	seen := make([]bool, g.Len())
+	seen[start] = true
+	queue := []ID{start}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, to := range g.out[id] {
+			if !seen[to] {
+				seen[to] = true
+				queue = append(queue, to)
+			}
+		}
+	}
+	return seen
+}
+
+// CountPaths returns the number of distinct paths from one node to another.
+// Each node's count is worked out once, so the result may be far larger
+// than the graph, and grows as a big.Int. A cycle on the way from from to
+// to makes the count undefined and is returned as a *CycleError; paths end
+// at to and nodes that cannot reach it are not followed, so cycles beyond
+// to or off to the side do not matter.
+func (g *Graph) CountPaths(from, to ID) (*big.Int, error) {
+	if from == to {
+		return big.NewInt(1), nil
+	}
+	reaches := g.reaching(to)
+	if !reaches[from] {
+		return new(big.Int), nil
+	}
+	state := make([]int8, g.Len())
+	paths := make([]*big.Int, g.Len())
+	zero := new(big.Int)
+	for id, ok := range reaches {
+		if !ok {
+			state[id], paths[id] = finished, zero
+		}
+	}
+	state[to], paths[to] = finished, big.NewInt(1)
+	err := g.dfs(from, state, func(id ID) {
+		paths[id] = new(big.Int)
+		for _, next := range g.out[id] {
+			paths[id].Add(paths[id], paths[next])
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return paths[from], nil
+}
+
+// reaching returns which nodes can reach to, to included, indexed by ID.
+func (g *Graph) reaching(to ID) []bool {
+	in := make([][]ID, g.Len())
+	for id, outs := range g.out {
+		for _, next := range outs {
+			in[next] = append(in[next], ID(id))
+		}
+	}
+	seen := make([]bool, g.Len())
+	seen[to] = true
+	queue := []ID{to}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, prev := range in[id] {
+			if !seen[prev] {
+				seen[prev] = true
+				queue = append(queue, prev)
+			}
+		}
+	}
+	return seen
+}
+
+// CountPathsVia returns the number of paths from one node to another that
+// pass through every node of via, in any order. In a graph without cycles
+// at most one order of via is possible, so the counts of the legs of each
+// order are multiplied and the orders added up.
+func (g *Graph) CountPathsVia(from, to ID, via ...ID) (*big.Int, error) {
+	total := new(big.Int)
+	var err error
+	permute(slices.Clone(via), 0, func(order []ID) {
+		if err != nil {
+			return
+		}
+		product := big.NewInt(1)
+		stops := append(append([]ID{from}, order...), to)
+		for i := 0; i+1 < len(stops) && product.Sign() != 0; i++ {
+			var n *big.Int
+			if n, err = g.CountPaths(stops[i], stops[i+1]); err != nil {
+				return
+			}
+			product.Mul(product, n)
+		}
+		total.Add(total, product)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return total, nil
+}
+
+// permute calls f with every ordering of ids[k:] after ids[:k].
+func permute(ids []ID, k int, f func([]ID)) {
+	if k == len(ids) {
+		f(ids)
+		return
+	}
+	for i := k; i < len(ids); i++ {
+		ids[k], ids[i] = ids[i], ids[k]
+		permute(ids, k+1, f)
+		ids[k], ids[i] = ids[i], ids[k]
+	}
+}
+
+// String lists the graph in the form Parse reads, one declared node per
+// line in the order of the input.
+func (g *Graph) String() string {
+	var b strings.Builder
+	for _, id := range g.lines {
+		fmt.Fprintf(&b, "%s:", g.names[id])
+		for _, to := range g.out[id] {
+			fmt.Fprintf(&b, " %s", g.names[to])
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
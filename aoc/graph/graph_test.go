@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+
+	"adventofcode/aoc"
+)
+
+func mustParse(t *testing.T, input string) *Graph {
+	t.Helper()
+	g, err := Parse(strings.Split(strings.TrimSpace(input), "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func id(t *testing.T, g *Graph, name string) ID {
+	t.Helper()
+	n, ok := g.Lookup(name)
+	if !ok {
+		t.Fatalf("no node %q", name)
+	}
+	return n
+}
+
+// The example of 2025 day 11 part 1.
+const devices = `
+aaa: you hhh
+you: bbb ccc
+bbb: ddd eee
+ccc: ddd eee fff
+ddd: ggg
+eee: out
+fff: out
+ggg: out
+hhh: ccc fff iii
+iii: out`
+
+func TestParse(t *testing.T) {
+	g := mustParse(t, devices)
+	if g.Len() != 11 {
+		t.Errorf("Len() = %d, want 11", g.Len())
+	}
+	if !g.Declared(id(t, g, "ccc")) || g.Declared(id(t, g, "out")) {
+		t.Error("Declared disagrees with the lines")
+	}
+	if got := g.String(); got != strings.TrimPrefix(devices, "\n")+"\n" {
+		t.Errorf("String() =\n%s", got)
+	}
+
+	for _, tt := range []struct {
+		lines     []string
+		line, col int
+	}{
+		{[]string{"a: b", "b c"}, 2, 0},
+		{[]string{"a: b", " : c"}, 2, 1},
+		{[]string{"a: b", "a: c"}, 2, 1},
+	} {
+		_, err := Parse(tt.lines)
+		var pe *aoc.ParseError
+		if !errors.As(err, &pe) || pe.Line != tt.line || pe.Col != tt.col {
+			t.Errorf("%q: got %v, want an error at line %d, column %d", tt.lines, err, tt.line, tt.col)
+		}
+	}
+}
+
+func TestTopoSort(t *testing.T) {
+	g := mustParse(t, devices)
+	order, err := g.TopoSort()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pos := make([]int, g.Len())
+	for i, n := range order {
+		pos[n] = i
+	}
+	for from := range ID(g.Len()) {
+		for _, to := range g.Out(from) {
+			if pos[from] >= pos[to] {
+				t.Errorf("%s comes after %s", g.Name(from), g.Name(to))
+			}
+		}
+	}
+}
+
+func TestCycle(t *testing.T) {
+	g := mustParse(t, "a: b\nb: c d\nc: e\nd: b")
+	if _, err := g.TopoSort(); err == nil {
+		t.Fatal("TopoSort: expected a cycle")
+	}
+	cycle := g.FindCycle()
+	if cycle == nil || !slices.Equal(cycle.Cycle, []string{"b", "d", "b"}) {
+		t.Errorf("FindCycle() = %v, want b -> d -> b", cycle)
+	}
+	if _, err := g.CountPaths(id(t, g, "a"), id(t, g, "e")); err == nil {
+		t.Error("CountPaths: expected a cycle error")
+	}
+	if n, err := g.CountPaths(id(t, g, "c"), id(t, g, "e")); err != nil || n.Int64() != 1 {
+		t.Errorf("CountPaths(c, e) = %d, %v; the cycle is not reachable from c", n, err)
+	}
+
+	// Cycles past the target or in a branch that never reaches it do not
+	// make the count undefined.
+	g = mustParse(t, "a: b x\nb: c\nc: d\nd: c\nx: y\ny: x")
+	if n, err := g.CountPaths(id(t, g, "a"), id(t, g, "b")); err != nil || n.Int64() != 1 {
+		t.Errorf("CountPaths(a, b) = %d, %v; no cycle lies between a and b", n, err)
+	}
+	// Paths end at the target, so a cycle through it is not followed.
+	if n, err := g.CountPaths(id(t, g, "a"), id(t, g, "d")); err != nil || n.Int64() != 1 {
+		t.Errorf("CountPaths(a, d) = %d, %v, want 1", n, err)
+	}
+}
+
+func TestCountPaths(t *testing.T) {
+	g := mustParse(t, devices)
+	if n, err := g.CountPaths(id(t, g, "you"), id(t, g, "out")); err != nil || n.Int64() != 5 {
+		t.Errorf("you to out: %d, %v, want 5", n, err)
+	}
+	if n, _ := g.CountPaths(id(t, g, "ddd"), id(t, g, "fff")); n.Sign() != 0 {
+		t.Errorf("ddd to fff: %d, want 0", n)
+	}
+
+	// Every path from aaa passes ccc or not; via ccc and eee, in either
+	// order, is aaa-you-ccc-eee and aaa-hhh-ccc-eee.
+	via, err := g.CountPathsVia(id(t, g, "aaa"), id(t, g, "out"), id(t, g, "eee"), id(t, g, "ccc"))
+	if err != nil || via.Int64() != 2 {
+		t.Errorf("aaa to out via eee and ccc: %d, %v, want 2", via, err)
+	}
+}
+
+// TestCountPathsBeyondInt64 counts the 2^100 paths through 100 diamonds
+// in a row.
+func TestCountPathsBeyondInt64(t *testing.T) {
+	var lines []string
+	for i := range 100 {
+		lines = append(lines,
+			fmt.Sprintf("n%d: l%d r%d", i, i, i),
+			fmt.Sprintf("l%d: n%d", i, i+1),
+			fmt.Sprintf("r%d: n%d", i, i+1))
+	}
+	g := mustParse(t, strings.Join(lines, "\n"))
+	n, err := g.CountPaths(id(t, g, "n0"), id(t, g, "n100"))
+	if want := new(big.Int).Lsh(big.NewInt(1), 100); err != nil || n.Cmp(want) != 0 {
+		t.Errorf("got %v, %v, want %v", n, err, want)
+	}
+}
+
+func TestReachable(t *testing.T) {
+	g := mustParse(t, devices)
+	seen := g.Reachable(id(t, g, "ccc"))
+	var got []string
+	for n, ok := range seen {
+		if ok {
+			got = append(got, g.Name(ID(n)))
+		}
+	}
+	slices.Sort(got)
+	want := []string{"ccc", "ddd", "eee", "fff", "ggg", "out"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,268 @@
+// Package grid is a rectangular two-dimensional grid of cells, as many
+// puzzles draw their input:
+//
+//	g, err := grid.Bytes(lines)
+//	for p, c := range g.Neighbours8(grid.Point{Row: 1, Col: 1}) {
+//		...
+//	}
+//
+// Lookups outside the grid are never an error: At reports false and the
+// iterators only visit cells inside it.
+package grid
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"adventofcode/aoc"
+)
+
+// Point is a cell position. Rows grow downwards, columns to the right.
+type Point struct {
+	Row, Col int
+}
+
+// Add returns p moved by d.
+func (p Point) Add(d Point) Point {
+	return Point{p.Row + d.Row, p.Col + d.Col}
+}
+
+// The four directions, as steps to add to a Point.
+var (
+	Up    = Point{-1, 0}
+	Down  = Point{1, 0}
+	Left  = Point{0, -1}
+	Right = Point{0, 1}
+)
+
+// Dirs4 are the steps to the four orthogonal neighbours, clockwise from up.
+var Dirs4 = []Point{Up, Right, Down, Left}
+
+// Dirs8 are the steps to the eight neighbours including the diagonals,
+// clockwise from up.
+var Dirs8 = []Point{Up, {-1, 1}, Right, {1, 1}, Down, {1, -1}, Left, {-1, -1}}
+
+// Grid is a rectangle of Width by Height cells of type T.
+type Grid[T any] struct {
+	Width, Height int
+	cells         []T // row by row
+}
+
+// New returns a grid of the given size with every cell the zero T.
+func New[T any](width, height int) *Grid[T] {
+	return &Grid[T]{Width: width, Height: height, cells: make([]T, width*height)}
+}
+
+// Parse builds a grid from input lines, one row per line, converting each
+// byte with cell. Every line must be as wide as the first. Errors carry the
+// line and column of the offending row or cell.
+func Parse[T any](lines []string, cell func(b byte) (T, error)) (*Grid[T], error) {
+	if len(lines) == 0 {
+		return nil, aoc.Errorf(1, 0, "empty grid")
+	}
+	g := New[T](len(lines[0]), len(lines))
+	for i, line := range lines {
+		if len(line) != g.Width {
+			return nil, aoc.Errorf(i+1, 0, "row has %d cells, expected %d", len(line), g.Width)
+		}
+		for j := 0; j < len(line); j++ {
+			v, err := cell(line[j])
+			if err != nil {
+				return nil, aoc.Errorf(i+1, j+1, "%v", err)
+			}
+			g.cells[i*g.Width+j] = v
+		}
+	}
+	return g, nil
+}
+
+// Bytes builds a grid holding the bytes of lines as they are.
+func Bytes(lines []string) (*Grid[byte], error) {
+	return Parse(lines, func(b byte) (byte, error) { return b, nil })
+}
+
+// In reports whether p is inside the grid.
+func (g *Grid[T]) In(p Point) bool {
+	return p.Row >= 0 && p.Row < g.Height && p.Col >= 0 && p.Col < g.Width
+}
+
+// At returns the cell at p, or the zero T and false when p is outside the
+// grid.
+func (g *Grid[T]) At(p Point) (T, bool) {
+	if !g.In(p) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[p.Row*g.Width+p.Col], true
+}
+
+// Set stores v at p and reports whether p is inside the grid; outside it,
+// Set does nothing.
+func (g *Grid[T]) Set(p Point, v T) bool {
+	if !g.In(p) {
+		return false
+	}
+	g.cells[p.Row*g.Width+p.Col] = v
+	return true
+}
+
+// All visits every cell row by row.
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, v := range g.cells {
+			if !yield(Point{i / g.Width, i % g.Width}, v) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbours4 visits the orthogonal neighbours of p that are inside the
+// grid.
+func (g *Grid[T]) Neighbours4(p Point) iter.Seq2[Point, T] {
+	return g.around(p, Dirs4)
+}
+
+// Neighbours8 visits the neighbours of p, diagonals included, that are
+// inside the grid.
+func (g *Grid[T]) Neighbours8(p Point) iter.Seq2[Point, T] {
+	return g.around(p, Dirs8)
+}
+
+func (g *Grid[T]) around(p Point, dirs []Point) iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for _, d := range dirs {
+			q := p.Add(d)
+			if v, ok := g.At(q); ok && !yield(q, v) {
+				return
+			}
+		}
+	}
+}
+
+// Ray visits the cells from start, inclusive, in steps of dir until it
+// leaves the grid. Rows, columns and diagonals are rays: Row and Col are
+// shorthands, and Ray(p, Point{1, 1}) walks a diagonal down to the right.
+func (g *Grid[T]) Ray(start, dir Point) iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		if dir == (Point{}) {
+			return
+		}
+		for p := start; g.In(p); p = p.Add(dir) {
+			if !yield(p, g.cells[p.Row*g.Width+p.Col]) {
+				return
+			}
+		}
+	}
+}
+
+// Row visits row r from left to right.
+func (g *Grid[T]) Row(r int) iter.Seq2[Point, T] {
+	return g.Ray(Point{r, 0}, Right)
+}
+
+// Col visits column c from top to bottom.
+func (g *Grid[T]) Col(c int) iter.Seq2[Point, T] {
+	return g.Ray(Point{0, c}, Down)
+}
+
+// FindAll returns the position of every cell equal to v, row by row.
+func FindAll[T comparable](g *Grid[T], v T) []Point {
+	var found []Point
+	for p, c := range g.All() {
+		if c == v {
+			found = append(found, p)
+		}
+	}
+	return found
+}
+
+// Clone returns a copy of g that shares no cells with it.
+func (g *Grid[T]) Clone() *Grid[T] {
+	return &Grid[T]{Width: g.Width, Height: g.Height, cells: append([]T(nil), g.cells...)}
+}
+
+// remap returns a width by height grid whose cell p is g's cell from(p).
+func (g *Grid[T]) remap(width, height int, from func(p Point) Point) *Grid[T] {
+	out := New[T](width, height)
+	for i := range out.cells {
+		q := from(Point{i / width, i % width})
+		out.cells[i] = g.cells[q.Row*g.Width+q.Col]
+	}
+	return out
+}
+
+// Transpose returns g mirrored in its main diagonal: rows become columns.
+func (g *Grid[T]) Transpose() *Grid[T] {
+	return g.remap(g.Height, g.Width, func(p Point) Point { return Point{p.Col, p.Row} })
+}
+
+// RotateRight returns g turned a quarter clockwise.
+func (g *Grid[T]) RotateRight() *Grid[T] {
+	return g.remap(g.Height, g.Width, func(p Point) Point { return Point{g.Height - 1 - p.Col, p.Row} })
+}
+
+// RotateLeft returns g turned a quarter anticlockwise.
+func (g *Grid[T]) RotateLeft() *Grid[T] {
+	return g.remap(g.Height, g.Width, func(p Point) Point { return Point{p.Col, g.Width - 1 - p.Row} })
+}
+
+// FlipH returns g mirrored left to right.
+func (g *Grid[T]) FlipH() *Grid[T] {
+	return g.remap(g.Width, g.Height, func(p Point) Point { return Point{p.Row, g.Width - 1 - p.Col} })
+}
+
+// FlipV returns g mirrored top to bottom.
+func (g *Grid[T]) FlipV() *Grid[T] {
+	return g.remap(g.Width, g.Height, func(p Point) Point { return Point{g.Height - 1 - p.Row, p.Col} })
+}
+
+// String draws the grid one row per line. Byte and rune cells are printed
+// as characters, as in the puzzle input; other cells with fmt, right
+// aligned in space separated columns.
+func (g *Grid[T]) String() string {
+	cells := make([]string, len(g.cells))
+	width := 0
+	for i, v := range g.cells {
+		switch c := any(v).(type) {
+		case byte:
+			cells[i] = string(rune(c))
+		case rune:
+			cells[i] = string(c)
+		default:
+			cells[i] = fmt.Sprint(v)
+		}
+		width = max(width, len(cells[i]))
+	}
+
+	var b strings.Builder
+	for i, s := range cells {
+		col := i % g.Width
+		if width > 1 {
+			if col > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(strings.Repeat(" ", width-len(s)))
+		}
+		b.WriteString(s)
+		if col == g.Width-1 {
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// Rows draws the grid as one string per row with a character per cell, the
+// inverse of Parse, e.g. for an aoc.Animator frame.
+func Rows[T any](g *Grid[T], char func(p Point, v T) byte) []string {
+	rows := make([]string, g.Height)
+	row := make([]byte, g.Width)
+	for r := range g.Height {
+		for p, v := range g.Row(r) {
+			row[p.Col] = char(p, v)
+		}
+		rows[r] = string(row)
+	}
+	return rows
+}
@@ -0,0 +1,146 @@
+package grid
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"adventofcode/aoc"
+)
+
+func mustBytes(t *testing.T, lines ...string) *Grid[byte] {
+	t.Helper()
+	g, err := Bytes(lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		lines     []string
+		line, col int
+	}{
+		{[]string{"@.@", "@."}, 2, 0},
+		{[]string{"@.@", "@x@"}, 2, 2},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.lines, func(b byte) (bool, error) {
+			if b != '@' && b != '.' {
+				return false, fmt.Errorf("expected '@' or '.', got %q", b)
+			}
+			return b == '@', nil
+		})
+		var pe *aoc.ParseError
+		if !errors.As(err, &pe) || pe.Line != tt.line || pe.Col != tt.col {
+			t.Errorf("%q: got %v, want an error at line %d, column %d", tt.lines, err, tt.line, tt.col)
+		}
+	}
+}
+
+func TestAtSetOutside(t *testing.T) {
+	g := mustBytes(t, "ab", "cd")
+	if v, ok := g.At(Point{1, 0}); !ok || v != 'c' {
+		t.Errorf("At(1,0) = %q, %v", v, ok)
+	}
+	for _, p := range []Point{{-1, 0}, {0, 2}, {2, 0}, {0, -1}} {
+		if _, ok := g.At(p); ok {
+			t.Errorf("At(%v) is inside", p)
+		}
+		if g.Set(p, 'x') {
+			t.Errorf("Set(%v) is inside", p)
+		}
+	}
+	if !g.Set(Point{0, 1}, 'x') || g.String() != "ax\ncd\n" {
+		t.Errorf("after Set: %q", g)
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	g := mustBytes(t, "abc", "def", "ghi")
+	collect := func(seq func(func(Point, byte) bool)) string {
+		var s []byte
+		for _, v := range seq {
+			s = append(s, v)
+		}
+		return string(s)
+	}
+	tests := []struct {
+		name, got, want string
+	}{
+		{"4 of centre", collect(g.Neighbours4(Point{1, 1})), "bfhd"},
+		{"8 of centre", collect(g.Neighbours8(Point{1, 1})), "bcfihgda"},
+		{"4 of corner", collect(g.Neighbours4(Point{0, 0})), "bd"},
+		{"8 of corner", collect(g.Neighbours8(Point{2, 2})), "fhe"},
+		{"row", collect(g.Row(1)), "def"},
+		{"col", collect(g.Col(2)), "cfi"},
+		{"diagonal", collect(g.Ray(Point{0, 0}, Point{1, 1})), "aei"},
+		{"anti-diagonal", collect(g.Ray(Point{2, 0}, Point{-1, 1})), "gec"},
+		{"outside", collect(g.Ray(Point{3, 3}, Up)), ""},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	g := mustBytes(t, "@.@", ".@.")
+	want := []Point{{0, 0}, {0, 2}, {1, 1}}
+	if got := FindAll(g, '@'); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	g := mustBytes(t, "abc", "def")
+	tests := []struct {
+		name string
+		got  *Grid[byte]
+		want string
+	}{
+		{"transpose", g.Transpose(), "ad\nbe\ncf\n"},
+		{"rotate right", g.RotateRight(), "da\neb\nfc\n"},
+		{"rotate left", g.RotateLeft(), "cf\nbe\nad\n"},
+		{"flip h", g.FlipH(), "cba\nfed\n"},
+		{"flip v", g.FlipV(), "def\nabc\n"},
+		{"four turns", g.RotateRight().RotateRight().RotateRight().RotateRight(), "abc\ndef\n"},
+	}
+	for _, tt := range tests {
+		if s := tt.got.String(); s != tt.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, s, tt.want)
+		}
+	}
+	if g.String() != "abc\ndef\n" {
+		t.Errorf("transforms changed the original:\n%s", g)
+	}
+}
+
+func TestStringAlignsWideCells(t *testing.T) {
+	g := New[int](3, 2)
+	g.Set(Point{0, 1}, 12)
+	g.Set(Point{1, 2}, 7)
+	if got, want := g.String(), " 0 12  0\n 0  0  7\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRowsRoundTrips(t *testing.T) {
+	lines := []string{"@.@", "..@"}
+	g, err := Parse(lines, func(b byte) (bool, error) { return b == '@', nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := Rows(g, func(p Point, roll bool) byte {
+		if roll {
+			return '@'
+		}
+		return '.'
+	})
+	if !slices.Equal(got, lines) {
+		t.Errorf("Rows = %q, want %q", got, lines)
+	}
+}
@@ -0,0 +1,191 @@
+// Package aoc holds the helpers shared by every day of the puzzle set,
+// starting with the input readers that used to be copied into each main.go.
+package aoc
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// InputFile is the name of the real puzzle input in a day's directory.
+const InputFile = "input.txt"
+
+// Stdin is the input path that stands for standard input.
+const Stdin = "-"
+
+// ExampleFile returns the file name of a day's nth worked example. Examples
+// are numbered from 1 and sit next to the real input, so example 1 is
+// input2.txt and example 2 is input3.txt.
+func ExampleFile(n int) string {
+	return fmt.Sprintf("input%d.txt", n+1)
+}
+
+// ReadInput returns the contents of the input at path, reading standard
+// input when path is Stdin.
+func ReadInput(path string) ([]byte, error) {
+	if path == Stdin {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("error reading stdin: %w", err)
+		}
+		return data, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open file: %w", err)
+	}
+	return data, nil
+}
+
+// maxLineSize bounds a single input line. Some puzzles (day02, day06) put
+// thousands of characters on one line, which is more than bufio's default.
+const maxLineSize = 1024 * 1024
+
+// ReadFile opens filename and hands it to one of the parsers below, e.g.
+// aoc.ReadFile("input.txt", aoc.Lines).
+func ReadFile[T any](filename string, parse func(io.Reader) (T, error)) (T, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("could not open file: %w", err)
+	}
+	defer file.Close()
+
+	return parse(file)
+}
+
+// Lines reads r line-by-line and returns a slice of strings without the
+// line terminators.
+func Lines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimRight(scanner.Text(), "\r"))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error during file scan: %w", err)
+	}
+
+	return lines, nil
+}
+
+// Section is a group of consecutive non-blank lines of an input.
+type Section struct {
+	Line  int // 1-based line number of Lines[0], for error messages
+	Lines []string
+}
+
+// Sections splits r into groups of lines separated by one or more blank
+// lines, e.g. the ranges and the ingredient IDs of day05.
+func Sections(r io.Reader) ([]Section, error) {
+	lines, err := Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var sections []Section
+	var current Section
+	for i, line := range lines {
+		if len(line) == 0 {
+			if len(current.Lines) > 0 {
+				sections = append(sections, current)
+				current = Section{}
+			}
+			continue
+		}
+		if len(current.Lines) == 0 {
+			current.Line = i + 1
+		}
+		current.Lines = append(current.Lines, line)
+	}
+	if len(current.Lines) > 0 {
+		sections = append(sections, current)
+	}
+
+	return sections, nil
+}
+
+// CommaList returns the comma separated items of every non-empty line in r,
+// trimmed of surrounding spaces. Empty items (a trailing comma) are dropped.
+func CommaList(r io.Reader) ([]string, error) {
+	lines, err := Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []string
+	for _, line := range lines {
+		for _, item := range strings.Split(line, ",") {
+			item = strings.TrimSpace(item)
+			if item != "" {
+				items = append(items, item)
+			}
+		}
+	}
+
+	return items, nil
+}
+
+// Ints returns every integer in r. Integers may be separated by spaces,
+// commas or newlines; anything else is reported as an error.
+func Ints(r io.Reader) ([]int, error) {
+	lines, err := Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var nums []int
+	for i, line := range lines {
+		lineNums, err := ParseInts(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		nums = append(nums, lineNums...)
+	}
+
+	return nums, nil
+}
+
+// ParseInts converts the space or comma separated integers of s.
+func ParseInts(s string) ([]int, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+
+	nums := make([]int, 0, len(fields))
+	for _, field := range fields {
+		num, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, err
+		}
+		nums = append(nums, num)
+	}
+
+	return nums, nil
+}
+
+// Grid returns the lines of r as mutable byte rows. Trailing empty lines are
+// dropped so that a final newline does not produce an empty row.
+func Grid(r io.Reader) ([][]byte, error) {
+	lines, err := Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	for len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+
+	grid := make([][]byte, len(lines))
+	for i, line := range lines {
+		grid[i] = []byte(line)
+	}
+
+	return grid, nil
+}
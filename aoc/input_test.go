@@ -0,0 +1,120 @@
+package aoc
+
+import (
+	"bufio"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name, input string
+		want        []string
+	}{
+		{"empty", "", nil},
+		{"final newline", "a\nb\n", []string{"a", "b"}},
+		{"no final newline", "a\nb", []string{"a", "b"}},
+		{"blank lines kept", "a\n\nb\n\n", []string{"a", "", "b", ""}},
+		{"CRLF", "a\r\n\r\nb\r\n", []string{"a", "", "b"}},
+		{"trailing spaces kept", "a  \n", []string{"a  "}},
+	}
+	for _, tt := range tests {
+		got, err := Lines(strings.NewReader(tt.input))
+		if err != nil || !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %q, %v, want %q", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestLinesLong(t *testing.T) {
+	// Longer than bufio's default token size, as day02 and day06 lines are.
+	long := strings.Repeat("1234567,", 20000)
+	got, err := Lines(strings.NewReader("x\n" + long + "\r\ny\n"))
+	if err != nil || len(got) != 3 || got[1] != long || got[2] != "y" {
+		t.Errorf("got %d lines, %v", len(got), err)
+	}
+
+	_, err = Lines(strings.NewReader(strings.Repeat("x", maxLineSize+1)))
+	if !errors.Is(err, bufio.ErrTooLong) {
+		t.Errorf("a line over maxLineSize: %v, want bufio.ErrTooLong", err)
+	}
+}
+
+func TestSections(t *testing.T) {
+	tests := []struct {
+		name, input string
+		want        []Section
+	}{
+		{"empty", "", nil},
+		{"one", "a\nb\n", []Section{{1, []string{"a", "b"}}}},
+		{"two", "3-5\n10-14\n\n1\n5\n", []Section{{1, []string{"3-5", "10-14"}}, {4, []string{"1", "5"}}}},
+		{"runs of blank lines", "\n\na\n\n\n\nb\n\n", []Section{{3, []string{"a"}}, {7, []string{"b"}}}},
+		{"CRLF", "a\r\nb\r\n\r\nc\r\n", []Section{{1, []string{"a", "b"}}, {4, []string{"c"}}}},
+	}
+	for _, tt := range tests {
+		got, err := Sections(strings.NewReader(tt.input))
+		if err != nil || !slices.EqualFunc(got, tt.want, func(a, b Section) bool {
+			return a.Line == b.Line && slices.Equal(a.Lines, b.Lines)
+		}) {
+			t.Errorf("%s: got %q, %v, want %q", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestCommaList(t *testing.T) {
+	tests := []struct {
+		name, input string
+		want        []string
+	}{
+		{"plain", "11-22,95-115\n", []string{"11-22", "95-115"}},
+		{"trailing comma", "11-22,95-115,\n", []string{"11-22", "95-115"}},
+		{"spaces and empty items", " a , ,b ,\n", []string{"a", "b"}},
+		{"several lines", "a,b,\r\n\r\nc\r\n", []string{"a", "b", "c"}},
+		{"empty", "", nil},
+	}
+	for _, tt := range tests {
+		got, err := CommaList(strings.NewReader(tt.input))
+		if err != nil || !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %q, %v, want %q", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestInts(t *testing.T) {
+	got, err := Ints(strings.NewReader("1, 2,3,\r\n-4\t5\n\n6\n"))
+	if want := []int{1, 2, 3, -4, 5, 6}; err != nil || !slices.Equal(got, want) {
+		t.Errorf("got %v, %v, want %v", got, err, want)
+	}
+
+	_, err = Ints(strings.NewReader("1 2\n3 x\n"))
+	if err == nil || !strings.HasPrefix(err.Error(), "line 2: ") {
+		t.Errorf("got %v, want an error on line 2", err)
+	}
+}
+
+func TestGrid(t *testing.T) {
+	got, err := Grid(strings.NewReader("..@\r\n@.@\r\n\r\n\n"))
+	if err != nil || len(got) != 2 || string(got[0]) != "..@" || string(got[1]) != "@.@" {
+		t.Fatalf("got %q, %v", got, err)
+	}
+	// Rows are mutable and do not share memory.
+	got[0][0] = '#'
+	if string(got[1]) != "@.@" {
+		t.Errorf("writing row 0 changed row 1 to %q", got[1])
+	}
+
+	if got, err := Grid(strings.NewReader("")); err != nil || len(got) != 0 {
+		t.Errorf("empty input: got %q, %v", got, err)
+	}
+}
+
+func TestExampleFile(t *testing.T) {
+	if got := ExampleFile(1); got != "input2.txt" {
+		t.Errorf("ExampleFile(1) = %q", got)
+	}
+	if got := ExampleFile(2); got != "input3.txt" {
+		t.Errorf("ExampleFile(2) = %q", got)
+	}
+}
@@ -0,0 +1,227 @@
+// Package interval is a set of integers kept as sorted, disjoint inclusive
+// ranges, for puzzles that deal in "start-end" spans of IDs or positions:
+//
+//	fresh := interval.Of(interval.Interval[uint64]{Lo: 3, Hi: 5}, ...)
+//	if fresh.Contains(id) { ... }
+//	n, _ := fresh.Len()
+//
+// No operation computes hi+1 or lo-1 where that could wrap, so ranges may
+// end at the smallest or largest value of their type.
+package interval
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Integer is the set of types an interval can range over.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Interval is the integers from Lo to Hi, both included. It is empty when
+// Lo > Hi.
+type Interval[T Integer] struct {
+	Lo, Hi T
+}
+
+// Empty reports whether iv holds no integers.
+func (iv Interval[T]) Empty() bool {
+	return iv.Lo > iv.Hi
+}
+
+// Len returns the number of integers in iv. ok is false only when iv spans
+// every value of a 64-bit type, one more than a uint64 holds.
+func (iv Interval[T]) Len() (n uint64, ok bool) {
+	if iv.Empty() {
+		return 0, true
+	}
+	// Converting both ends wraps them the same way, so the difference is
+	// right even for negative ends.
+	n = uint64(iv.Hi) - uint64(iv.Lo)
+	return n + 1, n != ^uint64(0)
+}
+
+func (iv Interval[T]) String() string {
+	return fmt.Sprintf("%d-%d", iv.Lo, iv.Hi)
+}
+
+// reaches reports whether a ends at or after x-1, so that a range starting
+// at x overlaps or touches it.
+func reaches[T Integer](a Interval[T], x T) bool {
+	return a.Hi >= x || a.Hi+1 == x
+}
+
+// Set is a set of integers. The zero value is an empty set.
+type Set[T Integer] struct {
+	ivs []Interval[T] // sorted, disjoint, none touching the next
+}
+
+// Of returns the set of the integers in any of ivs, which may overlap and
+// come in any order. It takes O(n log n) time.
+func Of[T Integer](ivs ...Interval[T]) *Set[T] {
+	sorted := make([]Interval[T], 0, len(ivs))
+	for _, iv := range ivs {
+		if !iv.Empty() {
+			sorted = append(sorted, iv)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Interval[T]) int {
+		switch {
+		case a.Lo < b.Lo:
+			return -1
+		case a.Lo > b.Lo:
+			return 1
+		}
+		return 0
+	})
+	return &Set[T]{ivs: merge(sorted)}
+}
+
+// merge joins the overlapping and touching neighbours of ivs, which are
+// sorted by Lo, in place.
+func merge[T Integer](ivs []Interval[T]) []Interval[T] {
+	out := ivs[:0]
+	for _, iv := range ivs {
+		if n := len(out); n > 0 && reaches(out[n-1], iv.Lo) {
+			out[n-1].Hi = max(out[n-1].Hi, iv.Hi)
+			continue
+		}
+		out = append(out, iv)
+	}
+	return out
+}
+
+// Intervals returns the ranges of s in ascending order, none overlapping or
+// touching another.
+func (s *Set[T]) Intervals() []Interval[T] {
+	return slices.Clone(s.ivs)
+}
+
+// Contains reports whether x is in s, in O(log n) time.
+func (s *Set[T]) Contains(x T) bool {
+	i := sort.Search(len(s.ivs), func(i int) bool { return s.ivs[i].Hi >= x })
+	return i < len(s.ivs) && s.ivs[i].Lo <= x
+}
+
+// Insert adds the integers from lo to hi, both included. It does nothing
+// when lo > hi.
+func (s *Set[T]) Insert(lo, hi T) {
+	if lo > hi {
+		return
+	}
+	// ivs[i:j] overlap or touch the new range and merge into it.
+	i := sort.Search(len(s.ivs), func(i int) bool { return reaches(s.ivs[i], lo) })
+	j := sort.Search(len(s.ivs), func(j int) bool { return !reaches(Interval[T]{hi, hi}, s.ivs[j].Lo) })
+	if i < j {
+		lo, hi = min(lo, s.ivs[i].Lo), max(hi, s.ivs[j-1].Hi)
+	}
+	s.ivs = slices.Replace(s.ivs, i, j, Interval[T]{lo, hi})
+}
+
+// Remove takes out the integers from lo to hi, both included. It does
+// nothing when lo > hi.
+func (s *Set[T]) Remove(lo, hi T) {
+	if lo > hi {
+		return
+	}
+	// ivs[i:j] overlap the removed range; what is left of them is at most
+	// a piece before lo and a piece after hi.
+	i := sort.Search(len(s.ivs), func(i int) bool { return s.ivs[i].Hi >= lo })
+	j := sort.Search(len(s.ivs), func(j int) bool { return s.ivs[j].Lo > hi })
+	if i == j {
+		return
+	}
+	var left []Interval[T]
+	if first := s.ivs[i]; first.Lo < lo {
+		left = append(left, Interval[T]{first.Lo, lo - 1})
+	}
+	if last := s.ivs[j-1]; last.Hi > hi {
+		left = append(left, Interval[T]{hi + 1, last.Hi})
+	}
+	s.ivs = slices.Replace(s.ivs, i, j, left...)
+}
+
+// Union returns the integers in s, t or both.
+func (s *Set[T]) Union(t *Set[T]) *Set[T] {
+	all := make([]Interval[T], 0, len(s.ivs)+len(t.ivs))
+	a, b := s.ivs, t.ivs
+	for len(a) > 0 || len(b) > 0 {
+		if len(b) == 0 || len(a) > 0 && a[0].Lo <= b[0].Lo {
+			all, a = append(all, a[0]), a[1:]
+		} else {
+			all, b = append(all, b[0]), b[1:]
+		}
+	}
+	return &Set[T]{ivs: merge(all)}
+}
+
+// Intersect returns the integers in both s and t.
+func (s *Set[T]) Intersect(t *Set[T]) *Set[T] {
+	var both []Interval[T]
+	a, b := s.ivs, t.ivs
+	for len(a) > 0 && len(b) > 0 {
+		if iv := (Interval[T]{max(a[0].Lo, b[0].Lo), min(a[0].Hi, b[0].Hi)}); !iv.Empty() {
+			both = append(both, iv)
+		}
+		if a[0].Hi < b[0].Hi {
+			a = a[1:]
+		} else {
+			b = b[1:]
+		}
+	}
+	return &Set[T]{ivs: both}
+}
+
+// Complement returns the integers from lo to hi, both included, that are
+// not in s.
+func (s *Set[T]) Complement(lo, hi T) *Set[T] {
+	var gaps []Interval[T]
+	if lo > hi {
+		return &Set[T]{}
+	}
+	next := lo // the smallest value not yet covered or ruled out
+	for _, iv := range s.ivs {
+		if iv.Hi < next {
+			continue
+		}
+		if iv.Lo > hi {
+			break
+		}
+		if iv.Lo > next {
+			gaps = append(gaps, Interval[T]{next, iv.Lo - 1})
+		}
+		if iv.Hi >= hi {
+			return &Set[T]{ivs: gaps}
+		}
+		next = iv.Hi + 1
+	}
+	return &Set[T]{ivs: append(gaps, Interval[T]{next, hi})}
+}
+
+// Len returns how many integers s holds. ok is false only when s holds
+// every value of a 64-bit type, one more than a uint64 holds.
+func (s *Set[T]) Len() (n uint64, ok bool) {
+	for _, iv := range s.ivs {
+		m, ok := iv.Len()
+		if !ok {
+			return 0, false
+		}
+		// Disjoint ranges add up to at most 2^64, and only a single range
+		// covering the whole type reaches it.
+		n += m
+	}
+	return n, true
+}
+
+// String lists the ranges of s, e.g. "3-5 10-20".
+func (s *Set[T]) String() string {
+	parts := make([]string, len(s.ivs))
+	for i, iv := range s.ivs {
+		parts[i] = iv.String()
+	}
+	return strings.Join(parts, " ")
+}
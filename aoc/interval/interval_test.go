@@ -0,0 +1,146 @@
+package interval
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestOfMerges(t *testing.T) {
+	s := Of([]Interval[int64]{{10, 14}, {16, 20}, {3, 5}, {12, 18}, {6, 6}, {30, 29}}...)
+	if got, want := s.String(), "3-6 10-20"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if n, ok := s.Len(); n != 15 || !ok {
+		t.Errorf("Len() = %d, %v, want 15", n, ok)
+	}
+	for x, want := range map[int64]bool{2: false, 3: true, 6: true, 7: false, 15: true, 20: true, 21: false} {
+		if s.Contains(x) != want {
+			t.Errorf("Contains(%d) = %v", x, !want)
+		}
+	}
+}
+
+func TestExtremes(t *testing.T) {
+	all := Of(Interval[int64]{math.MinInt64, math.MaxInt64})
+	if n, ok := all.Len(); ok {
+		t.Errorf("Len() of every int64 = %d, true; want false", n)
+	}
+	all.Remove(math.MinInt64, math.MinInt64)
+	all.Remove(math.MaxInt64, math.MaxInt64)
+	if n, ok := all.Len(); n != math.MaxUint64-1 || !ok {
+		t.Errorf("Len() = %d, %v, want 2^64-2", n, ok)
+	}
+	if got := all.Complement(math.MinInt64, math.MaxInt64).String(); got != "-9223372036854775808--9223372036854775808 9223372036854775807-9223372036854775807" {
+		t.Errorf("Complement = %s", got)
+	}
+
+	var u Set[uint64]
+	u.Insert(math.MaxUint64, math.MaxUint64)
+	u.Insert(0, 0)
+	u.Insert(1, math.MaxUint64-1)
+	if got := u.Intervals(); !slices.Equal(got, []Interval[uint64]{{0, math.MaxUint64}}) {
+		t.Errorf("touching inserts gave %v", got)
+	}
+	if c := u.Complement(0, math.MaxUint64); len(c.Intervals()) != 0 {
+		t.Errorf("Complement of everything = %v", c)
+	}
+}
+
+// bits is a set of the 256 values of an 8-bit type, indexed from its
+// smallest value.
+type bits [256]bool
+
+func (b *bits) set(lo, hi int, v bool) {
+	for x := lo; x <= hi; x++ {
+		b[x] = v
+	}
+}
+
+// check compares s with want, where index 0 of want stands for base.
+func check[T Integer](t *testing.T, op string, s *Set[T], want *bits, base int) {
+	t.Helper()
+	ivs := s.Intervals()
+	for i, iv := range ivs {
+		if iv.Empty() || i > 0 && !(int(ivs[i-1].Hi)+1 < int(iv.Lo)) {
+			t.Fatalf("%s: ranges %v not sorted, disjoint and apart", op, ivs)
+		}
+	}
+	count := 0
+	for i, in := range want {
+		if s.Contains(T(i+base)) != in {
+			t.Fatalf("%s: Contains(%d) = %v in %v", op, i+base, !in, s)
+		}
+		if in {
+			count++
+		}
+	}
+	if n, ok := s.Len(); int(n) != count || !ok {
+		t.Fatalf("%s: Len() = %d, %v, want %d", op, n, ok, count)
+	}
+}
+
+// TestAgainstBits runs random operations on sets of 8-bit integers, whose
+// ranges often reach the ends of the type, against a bitmap of all values.
+func TestAgainstBits(t *testing.T) {
+	t.Run("int8", func(t *testing.T) { againstBits[int8](t, math.MinInt8) })
+	t.Run("uint8", func(t *testing.T) { againstBits[uint8](t, 0) })
+}
+
+func againstBits[T Integer](t *testing.T, base int) {
+	r := rand.New(rand.NewPCG(1, uint64(base)))
+	// span picks a range, with its ends often at the ends of the type.
+	span := func() (int, int) {
+		end := func() int {
+			switch r.IntN(6) {
+			case 0:
+				return 0
+			case 1:
+				return 255
+			}
+			return r.IntN(256)
+		}
+		lo, hi := end(), end()
+		return min(lo, hi), max(lo, hi)
+	}
+	random := func() (*Set[T], *bits) {
+		var ivs []Interval[T]
+		var b bits
+		for range r.IntN(6) {
+			lo, hi := span()
+			ivs = append(ivs, Interval[T]{T(lo + base), T(hi + base)})
+			b.set(lo, hi, true)
+		}
+		return Of(ivs...), &b
+	}
+
+	for range 200 {
+		s, want := random()
+		check(t, "Of", s, want, base)
+		for range 20 {
+			lo, hi := span()
+			if r.IntN(2) == 0 {
+				s.Insert(T(lo+base), T(hi+base))
+				want.set(lo, hi, true)
+				check(t, "Insert", s, want, base)
+			} else {
+				s.Remove(T(lo+base), T(hi+base))
+				want.set(lo, hi, false)
+				check(t, "Remove", s, want, base)
+			}
+		}
+
+		o, other := random()
+		var union, both, gaps bits
+		lo, hi := span()
+		for i := range want {
+			union[i] = want[i] || other[i]
+			both[i] = want[i] && other[i]
+			gaps[i] = !want[i] && lo <= i && i <= hi
+		}
+		check(t, "Union", s.Union(o), &union, base)
+		check(t, "Intersect", s.Intersect(o), &both, base)
+		check(t, "Complement", s.Complement(T(lo+base), T(hi+base)), &gaps, base)
+	}
+}
@@ -0,0 +1,351 @@
+// Package linalg is exact linear algebra for puzzles that come down to a
+// system of linear equations, such as how many times to press each button.
+//
+// Matrix holds big.Rat entries and never rounds. IntMatrix holds int64
+// entries and reduces without fractions, which is much faster while the
+// numbers stay small; it reports ErrOverflow rather than a wrong answer
+// when they do not, and the caller can fall back to Matrix:
+//
+//	r, pivots, err := m.RREF()
+//	if errors.Is(err, linalg.ErrOverflow) {
+//		rr, pivots := m.Rat().RREF()
+//		...
+//	}
+package linalg
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"adventofcode/aoc/checked"
+)
+
+// Matrix is a matrix of rationals.
+type Matrix struct {
+	rows [][]*big.Rat
+	cols int
+}
+
+// New returns a rows×cols matrix of zeros.
+func New(rows, cols int) *Matrix {
+	m := &Matrix{rows: make([][]*big.Rat, rows), cols: cols}
+	for i := range m.rows {
+		m.rows[i] = make([]*big.Rat, cols)
+		for j := range cols {
+			m.rows[i][j] = new(big.Rat)
+		}
+	}
+	return m
+}
+
+// FromInts returns the matrix with the given rows, which must all have the
+// same length.
+func FromInts(rows [][]int64) *Matrix {
+	if len(rows) == 0 {
+		return New(0, 0)
+	}
+	m := New(len(rows), len(rows[0]))
+	for i, row := range rows {
+		if len(row) != m.cols {
+			panic(fmt.Sprintf("linalg: row %d has %d entries, row 0 has %d", i, len(row), m.cols))
+		}
+		for j, v := range row {
+			m.rows[i][j].SetInt64(v)
+		}
+	}
+	return m
+}
+
+// Rows returns the number of rows.
+func (m *Matrix) Rows() int {
+	return len(m.rows)
+}
+
+// Cols returns the number of columns.
+func (m *Matrix) Cols() int {
+	return m.cols
+}
+
+// At returns a copy of the entry at row i, column j.
+func (m *Matrix) At(i, j int) *big.Rat {
+	return new(big.Rat).Set(m.rows[i][j])
+}
+
+// Set sets the entry at row i, column j to a copy of v.
+func (m *Matrix) Set(i, j int, v *big.Rat) {
+	m.rows[i][j].Set(v)
+}
+
+// Clone returns a copy of m.
+func (m *Matrix) Clone() *Matrix {
+	c := New(m.Rows(), m.cols)
+	for i, row := range m.rows {
+		for j, v := range row {
+			c.rows[i][j].Set(v)
+		}
+	}
+	return c
+}
+
+// RREF returns the reduced row echelon form of m, and the column of the
+// leading 1 of each of its nonzero rows, which come first. m is unchanged.
+func (m *Matrix) RREF() (*Matrix, []int) {
+	r := m.Clone()
+	var pivots []int
+	t := new(big.Rat)
+	for j := 0; j < r.cols && len(pivots) < r.Rows(); j++ {
+		p := len(pivots)
+		sel := p
+		for sel < r.Rows() && r.rows[sel][j].Sign() == 0 {
+			sel++
+		}
+		if sel == r.Rows() {
+			continue
+		}
+		r.rows[p], r.rows[sel] = r.rows[sel], r.rows[p]
+		pivots = append(pivots, j)
+
+		inv := new(big.Rat).Inv(r.rows[p][j])
+		for k := j; k < r.cols; k++ {
+			r.rows[p][k].Mul(r.rows[p][k], inv)
+		}
+		for i, row := range r.rows {
+			if i == p || row[j].Sign() == 0 {
+				continue
+			}
+			f := new(big.Rat).Set(row[j])
+			for k := j; k < r.cols; k++ {
+				row[k].Sub(row[k], t.Mul(f, r.rows[p][k]))
+			}
+		}
+	}
+	return r, pivots
+}
+
+// Rank returns the number of linearly independent rows of m.
+func (m *Matrix) Rank() int {
+	_, pivots := m.RREF()
+	return len(pivots)
+}
+
+// FreeColumns returns the columns from 0 to cols-1 that are not in pivots,
+// which must be ascending: the free variables of a reduced system.
+func FreeColumns(pivots []int, cols int) []int {
+	var free []int
+	for j := range cols {
+		if len(pivots) > 0 && pivots[0] == j {
+			pivots = pivots[1:]
+			continue
+		}
+		free = append(free, j)
+	}
+	return free
+}
+
+// NullSpace returns a basis of the vectors x with m·x = 0, one per free
+// column of m, each with a 1 in its free column and 0 in the others.
+func (m *Matrix) NullSpace() [][]*big.Rat {
+	r, pivots := m.RREF()
+	var basis [][]*big.Rat
+	for _, f := range FreeColumns(pivots, m.cols) {
+		x := make([]*big.Rat, m.cols)
+		for j := range x {
+			x[j] = new(big.Rat)
+		}
+		x[f].SetInt64(1)
+		for i, p := range pivots {
+			x[p].Neg(r.rows[i][f])
+		}
+		basis = append(basis, x)
+	}
+	return basis
+}
+
+// Solve returns an x with m·x = b, taking 0 for every free variable, or
+// false if there is none. Every solution is x plus a combination of the
+// NullSpace basis.
+func (m *Matrix) Solve(b []*big.Rat) ([]*big.Rat, bool) {
+	if len(b) != m.Rows() {
+		panic(fmt.Sprintf("linalg: %d values for %d rows", len(b), m.Rows()))
+	}
+	aug := New(m.Rows(), m.cols+1)
+	for i, row := range m.rows {
+		for j, v := range row {
+			aug.rows[i][j].Set(v)
+		}
+		aug.rows[i][m.cols].Set(b[i])
+	}
+	r, pivots := aug.RREF()
+	if len(pivots) > 0 && pivots[len(pivots)-1] == m.cols {
+		return nil, false // a row 0 = nonzero
+	}
+	x := make([]*big.Rat, m.cols)
+	for j := range x {
+		x[j] = new(big.Rat)
+	}
+	for i, p := range pivots {
+		x[p].Set(r.rows[i][m.cols])
+	}
+	return x, true
+}
+
+// String lists m one row per line, e.g. "1 -1/2\n0 3\n".
+func (m *Matrix) String() string {
+	var b strings.Builder
+	for _, row := range m.rows {
+		for j, v := range row {
+			if j > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(v.RatString())
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// ErrOverflow reports that an IntMatrix computation left the int64 range.
+// It is checked.ErrOverflow, which the returned errors wrap.
+var ErrOverflow = checked.ErrOverflow
+
+// IntMatrix is a matrix of int64s.
+type IntMatrix struct {
+	rows [][]int64
+	cols int
+}
+
+// NewInt returns a rows×cols matrix of zeros.
+func NewInt(rows, cols int) *IntMatrix {
+	m := &IntMatrix{rows: make([][]int64, rows), cols: cols}
+	for i := range m.rows {
+		m.rows[i] = make([]int64, cols)
+	}
+	return m
+}
+
+// Rows returns the number of rows.
+func (m *IntMatrix) Rows() int {
+	return len(m.rows)
+}
+
+// Cols returns the number of columns.
+func (m *IntMatrix) Cols() int {
+	return m.cols
+}
+
+// At returns the entry at row i, column j.
+func (m *IntMatrix) At(i, j int) int64 {
+	return m.rows[i][j]
+}
+
+// Set sets the entry at row i, column j.
+func (m *IntMatrix) Set(i, j int, v int64) {
+	m.rows[i][j] = v
+}
+
+// Clone returns a copy of m.
+func (m *IntMatrix) Clone() *IntMatrix {
+	c := NewInt(m.Rows(), m.cols)
+	for i, row := range m.rows {
+		copy(c.rows[i], row)
+	}
+	return c
+}
+
+// Rat returns m as a Matrix.
+func (m *IntMatrix) Rat() *Matrix {
+	return FromInts(m.rows)
+}
+
+// RREF returns the fraction-free reduced row echelon form of m, and the
+// pivot column of each of its nonzero rows, which come first. It is the
+// RREF of m scaled so that every entry is an integer: each pivot row has
+// the same positive value d in its pivot column where Matrix.RREF has a 1,
+// so entry (i, j) of the rational form is r.At(i, j)/d.
+//
+// The elimination is Bareiss's: every division is exact and the entries
+// stay minors of m, far smaller than plain cross-multiplication makes them.
+// If one still leaves the int64 range, RREF returns an error wrapping
+// ErrOverflow.
+func (m *IntMatrix) RREF() (*IntMatrix, []int, error) {
+	r := m.Clone()
+	var pivots []int
+	prev := int64(1) // the pivot of the previous step
+	for j := 0; j < r.cols && len(pivots) < r.Rows(); j++ {
+		p := len(pivots)
+		sel := p
+		for sel < r.Rows() && r.rows[sel][j] == 0 {
+			sel++
+		}
+		if sel == r.Rows() {
+			continue
+		}
+		r.rows[p], r.rows[sel] = r.rows[sel], r.rows[p]
+		pivots = append(pivots, j)
+
+		pv := r.rows[p][j]
+		for i, row := range r.rows {
+			if i == p {
+				continue
+			}
+			f := row[j]
+			for k := range r.cols {
+				if k == j {
+					continue
+				}
+				// row[k] = (pv*row[k] - f*pivotRow[k]) / prev
+				a, err := checked.Mul(pv, row[k])
+				if err != nil {
+					return nil, nil, err
+				}
+				b, err := checked.Mul(f, r.rows[p][k])
+				if err != nil {
+					return nil, nil, err
+				}
+				d, err := checked.Sub(a, b)
+				if err != nil {
+					return nil, nil, err
+				}
+				row[k] = d / prev
+			}
+			row[j] = 0
+		}
+		prev = pv
+	}
+	if prev < 0 {
+		for _, row := range r.rows {
+			for k, v := range row {
+				var err error
+				if row[k], err = checked.Sub(0, v); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+	}
+	return r, pivots, nil
+}
+
+// Rank returns the number of linearly independent rows of m. It falls back
+// to rationals if the integer elimination overflows.
+func (m *IntMatrix) Rank() int {
+	if _, pivots, err := m.RREF(); err == nil {
+		return len(pivots)
+	}
+	return m.Rat().Rank()
+}
+
+// String lists m one row per line, e.g. "2 -1\n0 3\n".
+func (m *IntMatrix) String() string {
+	var b strings.Builder
+	for _, row := range m.rows {
+		for j, v := range row {
+			if j > 0 {
+				b.WriteByte(' ')
+			}
+			fmt.Fprint(&b, v)
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
@@ -0,0 +1,139 @@
+package linalg
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestRREF(t *testing.T) {
+	m := FromInts([][]int64{
+		{2, 4, 0, 2},
+		{1, 2, 1, 4},
+		{3, 6, 1, 6},
+	})
+	r, pivots := m.RREF()
+	if got, want := r.String(), "1 2 0 1\n0 0 1 3\n0 0 0 0\n"; got != want {
+		t.Errorf("RREF =\n%swant\n%s", got, want)
+	}
+	if !slices.Equal(pivots, []int{0, 2}) || m.Rank() != 2 {
+		t.Errorf("pivots %v, rank %d", pivots, m.Rank())
+	}
+	if free := FreeColumns(pivots, 4); !slices.Equal(free, []int{1, 3}) {
+		t.Errorf("FreeColumns = %v", free)
+	}
+	if m.At(0, 0).Cmp(big.NewRat(2, 1)) != 0 {
+		t.Error("RREF changed m")
+	}
+}
+
+// mulVec returns m·x.
+func mulVec(m *Matrix, x []*big.Rat) []*big.Rat {
+	out := make([]*big.Rat, m.Rows())
+	t := new(big.Rat)
+	for i := range out {
+		out[i] = new(big.Rat)
+		for j := range m.Cols() {
+			out[i].Add(out[i], t.Mul(m.rows[i][j], x[j]))
+		}
+	}
+	return out
+}
+
+func TestSolveAndNullSpace(t *testing.T) {
+	// The day 10 example machine "(3) (1,3) (2) (2,3) (0,2) (0,1) {3,5,4,7}"
+	// as counters × buttons.
+	m := FromInts([][]int64{
+		{0, 0, 0, 0, 1, 1},
+		{0, 1, 0, 0, 0, 1},
+		{0, 0, 1, 1, 1, 0},
+		{1, 1, 0, 1, 0, 0},
+	})
+	b := []*big.Rat{big.NewRat(3, 1), big.NewRat(5, 1), big.NewRat(4, 1), big.NewRat(7, 1)}
+	x, ok := m.Solve(b)
+	if !ok {
+		t.Fatal("Solve found no solution")
+	}
+	for i, v := range mulVec(m, x) {
+		if v.Cmp(b[i]) != 0 {
+			t.Errorf("m·x row %d = %s, want %s", i, v, b[i])
+		}
+	}
+	basis := m.NullSpace()
+	if len(basis) != m.Cols()-m.Rank() {
+		t.Errorf("%d basis vectors, want %d", len(basis), m.Cols()-m.Rank())
+	}
+	for _, v := range basis {
+		for i, y := range mulVec(m, v) {
+			if y.Sign() != 0 {
+				t.Errorf("null vector %v gives %s in row %d", v, y, i)
+			}
+		}
+	}
+
+	if _, ok := FromInts([][]int64{{1, 1}, {2, 2}}).Solve([]*big.Rat{big.NewRat(1, 1), big.NewRat(3, 1)}); ok {
+		t.Error("Solve found a solution of x+y = 1, 2x+2y = 3")
+	}
+}
+
+// TestIntMatchesRat checks the fraction-free form against the rational one
+// on random matrices, rank-deficient ones included.
+func TestIntMatchesRat(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for range 500 {
+		rows, cols := 1+r.IntN(6), 1+r.IntN(7)
+		m := NewInt(rows, cols)
+		for i := range rows {
+			for j := range cols {
+				m.Set(i, j, int64(r.IntN(11)-5))
+			}
+			if i > 0 && r.IntN(4) == 0 { // a multiple of the row above
+				for j := range cols {
+					m.Set(i, j, 2*m.At(i-1, j))
+				}
+			}
+		}
+		ri, pi, err := m.RREF()
+		if err != nil {
+			t.Fatal(err)
+		}
+		rr, pr := m.Rat().RREF()
+		if !slices.Equal(pi, pr) {
+			t.Fatalf("pivots %v, want %v for\n%s", pi, pr, m)
+		}
+		if len(pi) == 0 {
+			continue
+		}
+		d := ri.At(0, pi[0])
+		for i := range rows {
+			for j := range cols {
+				if i < len(pi) && j == pi[i] && ri.At(i, j) != d {
+					t.Fatalf("pivot %d is %d, pivot 0 is %d", i, ri.At(i, j), d)
+				}
+				if got := big.NewRat(ri.At(i, j), d); got.Cmp(rr.At(i, j)) != 0 {
+					t.Fatalf("entry (%d, %d) is %s, want %s for\n%s", i, j, got.RatString(), rr.At(i, j).RatString(), m)
+				}
+			}
+		}
+		if d <= 0 {
+			t.Fatalf("pivot value %d is not positive", d)
+		}
+	}
+}
+
+func TestIntOverflow(t *testing.T) {
+	m := NewInt(2, 2)
+	m.Set(0, 0, math.MaxInt64)
+	m.Set(0, 1, 3)
+	m.Set(1, 0, 5)
+	m.Set(1, 1, math.MaxInt64)
+	if _, _, err := m.RREF(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("RREF: got %v, want ErrOverflow", err)
+	}
+	if m.Rank() != 2 {
+		t.Errorf("Rank() = %d, want 2 through the rational fallback", m.Rank())
+	}
+}
@@ -0,0 +1,182 @@
+package aoc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"path/filepath"
+	"runtime"
+	"sort"
+)
+
+// Solver runs one part of a puzzle against its raw input and returns the
+// answer to print. Answers are printed and compared in their fmt.Sprint
+// form, so any integer type works, *big.Int included for answers that can
+// outgrow an int64.
+type Solver func(r io.Reader) (any, error)
+
+// Validator checks a raw input against the day's declared grammar and
+// returns every deviation, normally as *ParseError values. See package
+// aoc/grammar.
+type Validator func(r io.Reader) []error
+
+// Generator writes a random input of the day's format, valid under its
+// grammar and solvable by its solvers. Size scales the input, e.g. the number
+// of lines or the side of a grid; each generator documents its meaning.
+type Generator func(r *rand.Rand, size int) []byte
+
+// Animator replays the day's simulation on its raw input and calls frame
+// with the grid, one string per row, at the start and after every step.
+// frame may keep rows but not change them. See package aoc/anim.
+type Animator func(r io.Reader, frame func(rows []string)) error
+
+// Puzzle is one day of the set as seen by the runner.
+type Puzzle struct {
+	Year  int
+	Day   int
+	Part1 Solver
+	Part2 Solver
+
+	// Validate, when set, is run on an input before either solver.
+	Validate Validator
+
+	// Generate, when set, makes random inputs for tests and benchmarks.
+	Generate Generator
+
+	// Animate, when set, draws the day's simulation step by step.
+	Animate Animator
+
+	// Dir is the directory holding the day's source and input files. It is
+	// filled in by Register from the caller's location when left empty.
+	Dir string
+}
+
+// Part returns the solver for part 1 or 2.
+func (p Puzzle) Part(n int) (Solver, error) {
+	switch n {
+	case 1:
+		return p.Part1, nil
+	case 2:
+		return p.Part2, nil
+	}
+	return nil, fmt.Errorf("%d day %02d has no part %d", p.Year, p.Day, n)
+}
+
+// Input returns the path of a file in the day's directory, e.g. "input.txt".
+func (p Puzzle) Input(name string) string {
+	return filepath.Join(p.Dir, name)
+}
+
+// Solve runs part n against the given input.
+func (p Puzzle) Solve(n int, input []byte) (any, error) {
+	solve, err := p.Part(n)
+	if err != nil {
+		return nil, err
+	}
+	return solve(bytes.NewReader(input))
+}
+
+// Check returns every deviation of input from the day's grammar, or nil
+// when the day declares none.
+func (p Puzzle) Check(input []byte) []error {
+	if p.Validate == nil {
+		return nil
+	}
+	return p.Validate(bytes.NewReader(input))
+}
+
+// NewInput returns the input the day's generator makes for seed and size.
+// The same seed and size always give the same input.
+func (p Puzzle) NewInput(seed uint64, size int) ([]byte, error) {
+	if p.Generate == nil {
+		return nil, fmt.Errorf("%d day %02d has no input generator", p.Year, p.Day)
+	}
+	if size < 1 {
+		return nil, fmt.Errorf("input size must be at least 1, got %d", size)
+	}
+	return p.Generate(rand.New(rand.NewPCG(seed, uint64(p.Year)*100+uint64(p.Day))), size), nil
+}
+
+// Replay runs the day's animator on input, see Animator.
+func (p Puzzle) Replay(input []byte, frame func(rows []string)) error {
+	if p.Animate == nil {
+		return fmt.Errorf("%d day %02d has no animation", p.Year, p.Day)
+	}
+	return p.Animate(bytes.NewReader(input), frame)
+}
+
+// SolveFile runs part n against the input at path, see ReadInput. Parse
+// errors name the file.
+func (p Puzzle) SolveFile(n int, path string) (any, error) {
+	data, err := ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+	answer, err := p.Solve(n, data)
+	return answer, InFile(err, path)
+}
+
+type key struct{ year, day int }
+
+var puzzles = map[key]Puzzle{}
+
+// Register makes a day available to the runner. Days call it from an init
+// function, so importing a day's package is enough to register it.
+func Register(p Puzzle) {
+	k := key{p.Year, p.Day}
+	if _, ok := puzzles[k]; ok {
+		panic(fmt.Sprintf("aoc: %d day %02d registered twice", p.Year, p.Day))
+	}
+	if p.Dir == "" {
+		if _, file, _, ok := runtime.Caller(1); ok {
+			p.Dir = filepath.Dir(file)
+		}
+	}
+	puzzles[k] = p
+}
+
+// Lookup returns the registered puzzle for the given year and day.
+func Lookup(year, day int) (Puzzle, bool) {
+	p, ok := puzzles[key{year, day}]
+	return p, ok
+}
+
+// Puzzles returns every registered puzzle ordered by year and day.
+func Puzzles() []Puzzle {
+	list := make([]Puzzle, 0, len(puzzles))
+	for _, p := range puzzles {
+		list = append(list, p)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Year != list[j].Year {
+			return list[i].Year < list[j].Year
+		}
+		return list[i].Day < list[j].Day
+	})
+	return list
+}
+
+// Years returns every year with a registered puzzle in ascending order.
+func Years() []int {
+	var years []int
+	for _, p := range Puzzles() {
+		if len(years) == 0 || years[len(years)-1] != p.Year {
+			years = append(years, p.Year)
+		}
+	}
+	return years
+}
+
+// With adapts a solver over parsed input, e.g.
+// solvePart1(lines []string) (int, error), to the Solver signature using one
+// of the input parsers.
+func With[T, R any](parse func(io.Reader) (T, error), solve func(T) (R, error)) Solver {
+	return func(r io.Reader) (any, error) {
+		input, err := parse(r)
+		if err != nil {
+			return nil, err
+		}
+		return solve(input)
+	}
+}
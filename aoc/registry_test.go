@@ -0,0 +1,33 @@
+package aoc
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestNewInputIsReproducible(t *testing.T) {
+	p := Puzzle{Year: 2025, Day: 1, Generate: func(r *rand.Rand, size int) []byte {
+		return fmt.Appendf(nil, "%d %d\n", size, r.IntN(1000000))
+	}}
+
+	a, err := p.NewInput(7, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := p.NewInput(7, 10)
+	if !bytes.Equal(a, b) {
+		t.Errorf("seed 7 gave %q, then %q", a, b)
+	}
+	if c, _ := p.NewInput(8, 10); bytes.Equal(a, c) {
+		t.Errorf("seeds 7 and 8 both gave %q", a)
+	}
+
+	if _, err := p.NewInput(7, 0); err == nil {
+		t.Error("size 0: expected an error")
+	}
+	if _, err := (Puzzle{Year: 2025, Day: 2}).NewInput(7, 10); err == nil {
+		t.Error("no generator: expected an error")
+	}
+}
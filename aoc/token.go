@@ -0,0 +1,107 @@
+package aoc
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Token is a piece of an input line that remembers where it came from, so
+// that a failed conversion can be reported with its column.
+type Token struct {
+	Text string
+	Col  int // 1-based byte column of the first byte of Text
+}
+
+// Split splits line around sep like strings.Split.
+func Split(line, sep string) []Token {
+	return Token{Text: line, Col: 1}.Split(sep)
+}
+
+// Fields splits line around runs of white space like strings.Fields.
+func Fields(line string) []Token {
+	return Token{Text: line, Col: 1}.Fields()
+}
+
+// Split splits t around sep like strings.Split, keeping track of columns.
+func (t Token) Split(sep string) []Token {
+	parts := strings.Split(t.Text, sep)
+	tokens := make([]Token, len(parts))
+	col := t.Col
+	for i, part := range parts {
+		tokens[i] = Token{Text: part, Col: col}
+		col += len(part) + len(sep)
+	}
+	return tokens
+}
+
+// Fields splits t around runs of white space like strings.Fields, keeping
+// track of columns.
+func (t Token) Fields() []Token {
+	var tokens []Token
+	start := -1
+	for i, r := range t.Text {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				tokens = append(tokens, Token{Text: t.Text[start:i], Col: t.Col + start})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, Token{Text: t.Text[start:], Col: t.Col + start})
+	}
+	return tokens
+}
+
+// TrimSpace removes leading and trailing white space from t.
+func (t Token) TrimSpace() Token {
+	trimmed := strings.TrimLeftFunc(t.Text, unicode.IsSpace)
+	col := t.Col + len(t.Text) - len(trimmed)
+	return Token{Text: strings.TrimRightFunc(trimmed, unicode.IsSpace), Col: col}
+}
+
+// Errorf returns a ParseError pointing at t on the given line.
+func (t Token) Errorf(line int, format string, args ...any) error {
+	return Errorf(line, t.Col, format, args...)
+}
+
+// Atoi converts t to an int. line is the 1-based line t was found on.
+func (t Token) Atoi(line int) (int, error) {
+	n, err := strconv.Atoi(t.Text)
+	return n, t.numError(line, "integer", err)
+}
+
+// ParseInt converts t to an int64.
+func (t Token) ParseInt(line int) (int64, error) {
+	n, err := strconv.ParseInt(t.Text, 10, 64)
+	return n, t.numError(line, "integer", err)
+}
+
+// ParseUint converts t to a uint64.
+func (t Token) ParseUint(line int) (uint64, error) {
+	n, err := strconv.ParseUint(t.Text, 10, 64)
+	return n, t.numError(line, "unsigned integer", err)
+}
+
+// ParseFloat converts t to a float64.
+func (t Token) ParseFloat(line int) (float64, error) {
+	f, err := strconv.ParseFloat(t.Text, 64)
+	return f, t.numError(line, "number", err)
+}
+
+func (t Token) numError(line int, kind string, err error) error {
+	if err == nil {
+		return nil
+	}
+	// strconv's message repeats the function name and the text; keep only
+	// the reason (invalid syntax, value out of range).
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		err = numErr.Err
+	}
+	return &ParseError{Line: line, Col: t.Col, Msg: "invalid " + kind + " " + strconv.Quote(t.Text), Err: err}
+}
@@ -0,0 +1,53 @@
+package aoc
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestSplitColumns(t *testing.T) {
+	tokens := Split("12-345, 6-7", ",")
+	if len(tokens) != 2 {
+		t.Fatalf("got %d tokens, want 2", len(tokens))
+	}
+	second := tokens[1].TrimSpace()
+	if second.Text != "6-7" || second.Col != 9 {
+		t.Errorf("got %q at column %d, want \"6-7\" at column 9", second.Text, second.Col)
+	}
+	end := second.Split("-")[1]
+	if end.Text != "7" || end.Col != 11 {
+		t.Errorf("got %q at column %d, want \"7\" at column 11", end.Text, end.Col)
+	}
+}
+
+func TestFieldsColumns(t *testing.T) {
+	tokens := Fields("  123 328\t 51")
+	want := []Token{{"123", 3}, {"328", 7}, {"51", 12}}
+	if len(tokens) != len(want) {
+		t.Fatalf("got %v, want %v", tokens, want)
+	}
+	for i := range want {
+		if tokens[i] != want[i] {
+			t.Errorf("token %d: got %v, want %v", i, tokens[i], want[i])
+		}
+	}
+}
+
+func TestParseErrorPosition(t *testing.T) {
+	_, err := Split("L10,R5x", ",")[1].Atoi(3)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got, want := err.Error(), `line 3, column 5: invalid integer "R5x": invalid syntax`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("%v does not wrap strconv.ErrSyntax", err)
+	}
+
+	err = InFile(err, "day01/input.txt")
+	if got, want := err.Error(), `day01/input.txt:3:5: invalid integer "R5x": invalid syntax`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
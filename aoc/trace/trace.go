@@ -0,0 +1,172 @@
+// Package trace is a small leveled debug log for the solvers. Output goes to
+// stderr and is off unless enabled per topic, either with the AOC_TRACE
+// environment variable or the runner's -trace flag, e.g.
+//
+//	AOC_TRACE=day07 go test ./day07
+//	go run ./cmd/aoc run -day 10 -trace day10=verbose
+//
+// A spec is a comma separated list of topic[=level] entries. A topic enables
+// itself and its sub-topics ("day10" also enables "day10.rref"), "all" enables
+// every topic, and the level defaults to debug.
+package trace
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Level orders how chatty a message is. A topic enabled at a level prints
+// messages of that level and below.
+type Level int
+
+const (
+	Off Level = iota
+	// Info is for rare, noteworthy events, e.g. a search giving up.
+	Info
+	// Debug is for a handful of lines per run, e.g. per input line results.
+	Debug
+	// Verbose is for inner-loop detail that can run to thousands of lines.
+	Verbose
+)
+
+var levelNames = []string{"off", "info", "debug", "verbose"}
+
+func (l Level) String() string {
+	if l >= 0 && int(l) < len(levelNames) {
+		return levelNames[l]
+	}
+	return fmt.Sprintf("Level(%d)", int(l))
+}
+
+// ParseLevel converts a level name such as "debug" to a Level.
+func ParseLevel(name string) (Level, error) {
+	for l, n := range levelNames {
+		if strings.EqualFold(n, name) {
+			return Level(l), nil
+		}
+	}
+	return Off, fmt.Errorf("unknown trace level %q", name)
+}
+
+// EnvVar is the environment variable read at start-up.
+const EnvVar = "AOC_TRACE"
+
+// config is the parsed spec. It is replaced as a whole by Configure, so
+// readers never need a lock.
+type config struct {
+	topics map[string]Level
+	all    Level
+}
+
+var (
+	current atomic.Pointer[config]
+
+	mu     sync.Mutex
+	output io.Writer = os.Stderr
+)
+
+func init() {
+	if err := Configure(os.Getenv(EnvVar)); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", EnvVar, err)
+	}
+}
+
+// Configure replaces the enabled topics with those in spec. An empty spec
+// turns tracing off.
+func Configure(spec string) error {
+	cfg := &config{topics: map[string]Level{}}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		topic, levelName, hasLevel := strings.Cut(entry, "=")
+		level := Debug
+		if hasLevel {
+			l, err := ParseLevel(levelName)
+			if err != nil {
+				return err
+			}
+			level = l
+		}
+		if topic == "all" {
+			cfg.all = level
+		} else {
+			cfg.topics[topic] = level
+		}
+	}
+	current.Store(cfg)
+	return nil
+}
+
+// SetOutput redirects all trace output, e.g. to a buffer in a test.
+func SetOutput(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+	output = w
+}
+
+// Tracer writes messages for one topic, normally the day's package name.
+type Tracer struct {
+	topic string
+}
+
+// New returns the tracer for topic. Solvers keep it in a package variable:
+//
+//	var tr = trace.New("day07")
+func New(topic string) *Tracer {
+	return &Tracer{topic: topic}
+}
+
+// Enabled reports whether messages at level would be printed. Guard loops
+// that only exist to build a trace message with it.
+func (t *Tracer) Enabled(level Level) bool {
+	cfg := current.Load()
+	if cfg == nil {
+		return false
+	}
+	enabled := cfg.all
+	// The most specific topic wins: "day10.rref" before "day10".
+	for topic := t.topic; ; {
+		if l, ok := cfg.topics[topic]; ok {
+			enabled = l
+			break
+		}
+		i := strings.LastIndexByte(topic, '.')
+		if i < 0 {
+			break
+		}
+		topic = topic[:i]
+	}
+	return level <= enabled
+}
+
+// Infof prints a message at Info level.
+func (t *Tracer) Infof(format string, args ...any) {
+	t.printf(Info, format, args...)
+}
+
+// Debugf prints a message at Debug level.
+func (t *Tracer) Debugf(format string, args ...any) {
+	t.printf(Debug, format, args...)
+}
+
+// Verbosef prints a message at Verbose level.
+func (t *Tracer) Verbosef(format string, args ...any) {
+	t.printf(Verbose, format, args...)
+}
+
+func (t *Tracer) printf(level Level, format string, args ...any) {
+	if !t.Enabled(level) {
+		return
+	}
+	msg := fmt.Sprintf(format, args...)
+
+	mu.Lock()
+	defer mu.Unlock()
+	fmt.Fprintf(output, "%s %s: %s\n", t.topic, level, strings.TrimSuffix(msg, "\n"))
+}
@@ -0,0 +1,62 @@
+package trace
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEnabled(t *testing.T) {
+	defer Configure("")
+
+	tests := []struct {
+		spec  string
+		topic string
+		level Level
+		want  bool
+	}{
+		{"", "day07", Info, false},
+		{"day07", "day07", Debug, true},
+		{"day07", "day07", Verbose, false},
+		{"day07", "day04", Info, false},
+		{"day10=verbose", "day10.rref", Verbose, true},
+		{"day10,day10.rref=off", "day10.rref", Info, false},
+		{"day10,day10.rref=off", "day10", Debug, true},
+		{"all=info", "day12", Info, true},
+		{"all=info", "day12", Debug, false},
+		{"all=info, day12=VERBOSE", "day12", Verbose, true},
+	}
+
+	for _, tt := range tests {
+		if err := Configure(tt.spec); err != nil {
+			t.Fatalf("Configure(%q): %v", tt.spec, err)
+		}
+		if got := New(tt.topic).Enabled(tt.level); got != tt.want {
+			t.Errorf("spec %q: %s enabled at %s = %v, want %v", tt.spec, tt.topic, tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestConfigureRejectsUnknownLevel(t *testing.T) {
+	defer Configure("")
+
+	if err := Configure("day07=loud"); err == nil {
+		t.Error("Configure accepted an unknown level")
+	}
+}
+
+func TestOutput(t *testing.T) {
+	var buf bytes.Buffer
+	old := output
+	SetOutput(&buf)
+	defer SetOutput(old)
+	defer Configure("")
+
+	Configure("day04")
+	tr := New("day04")
+	tr.Debugf("location: i: %d, j: %d\n", 1, 2)
+	tr.Verbosef("not printed")
+
+	if got, want := buf.String(), "day04 debug: location: i: 1, j: 2\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/anim"
+)
+
+// animCmd records a day's simulation one frame per step and writes it as an
+// animated GIF, or as a PNG of the final frame, plus a PNG per frame with
+// -frames:
+//
+//	aoc anim -day 4 -example 1 -scale 16 -o day04.gif
+func animCmd(args []string) error {
+	fs := flag.NewFlagSet("anim", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to animate (1-25)")
+	input := fs.String("input", "", "input file, or - for stdin (default: the day's input.txt)")
+	example := fs.Int("example", 0, "animate the day's nth example instead (1 is input2.txt, 2 is input3.txt)")
+	output := fs.String("o", "", "file to write: an animated .gif, or a .png of the last frame")
+	frames := fs.String("frames", "", "directory to write every frame to as frame0001.png, ...")
+	scale := fs.Int("scale", 4, "side of a grid cell in pixels")
+	delay := fs.Int("delay", 10, "time per GIF frame in hundredths of a second")
+	palette := fs.String("palette", "", "colours over the defaults, e.g. '@=#ffff66,.=#000000'")
+	fs.Parse(args)
+
+	if *day == 0 {
+		return errors.New("-day is required")
+	}
+	if *input != "" && *example != 0 {
+		return errors.New("-input and -example are mutually exclusive")
+	}
+	if *output == "" && *frames == "" {
+		return errors.New("-o or -frames is required")
+	}
+	ext := strings.ToLower(filepath.Ext(*output))
+	if *output != "" && ext != ".gif" && ext != ".png" {
+		return fmt.Errorf("-o %s: expected a .gif or .png file", *output)
+	}
+	pal, err := anim.ParsePalette(*palette)
+	if err != nil {
+		return err
+	}
+	puzzles, err := selectPuzzles(*year, *day)
+	if err != nil {
+		return err
+	}
+	p := puzzles[0]
+
+	path := *input
+	switch {
+	case *example > 0:
+		path = p.Input(aoc.ExampleFile(*example))
+	case path == "":
+		path = p.Input(aoc.InputFile)
+	}
+	data, err := aoc.ReadInput(path)
+	if err != nil {
+		return err
+	}
+	rec := &anim.Recorder{Palette: pal, Scale: *scale, Delay: *delay}
+	if err := p.Replay(data, rec.Frame); err != nil {
+		return aoc.InFile(err, path)
+	}
+	if rec.Len() == 0 {
+		return errors.New("the animation has no frames")
+	}
+
+	if *frames != "" {
+		if err := os.MkdirAll(*frames, 0o755); err != nil {
+			return err
+		}
+		for i := range rec.Len() {
+			name := filepath.Join(*frames, fmt.Sprintf("frame%04d.png", i+1))
+			if err := writeFile(name, func(f *os.File) error { return rec.WritePNG(f, i) }); err != nil {
+				return err
+			}
+		}
+	}
+	switch ext {
+	case ".gif":
+		err = writeFile(*output, func(f *os.File) error { return rec.WriteGIF(f) })
+	case ".png":
+		err = writeFile(*output, func(f *os.File) error { return rec.WritePNG(f, rec.Len()-1) })
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d day %02d: %d frames\n", p.Year, p.Day, rec.Len())
+	return nil
+}
+
+// writeFile creates the file name and fills it with write.
+func writeFile(name string, write func(f *os.File) error) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+	"text/tabwriter"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/aoctest"
+)
+
+// benchResult is one line of the bench report and of the baseline file.
+type benchResult struct {
+	NsPerOp     int64 `json:"ns_per_op"`
+	AllocsPerOp int64 `json:"allocs_per_op"`
+	BytesPerOp  int64 `json:"bytes_per_op"`
+}
+
+// benchCmd benchmarks the solvers on their real inputs, or on generated ones
+// with -size, and, when a baseline file is given, compares each part against
+// it.
+func benchCmd(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to benchmark; every registered day when 0")
+	part := fs.Int("part", 0, "part to benchmark (1 or 2); both parts when 0")
+	baseline := fs.String("baseline", "", "JSON file with earlier results to compare against")
+	save := fs.Bool("save", false, "write this run's results to the -baseline file")
+	size := fs.Int("size", 0, "benchmark on a generated input of this size instead of input.txt")
+	seed := fs.Uint64("seed", 1, "seed of the generated input")
+	fs.Parse(args)
+
+	if *save && *baseline == "" {
+		return errors.New("-save needs a -baseline file to write")
+	}
+
+	puzzles, err := selectPuzzles(*year, *day)
+	if err != nil {
+		return err
+	}
+	parts := []int{1, 2}
+	switch *part {
+	case 0:
+	case 1, 2:
+		parts = []int{*part}
+	default:
+		return fmt.Errorf("-part must be 1 or 2, got %d", *part)
+	}
+
+	previous := map[string]benchResult{}
+	if *baseline != "" {
+		data, err := os.ReadFile(*baseline)
+		switch {
+		case errors.Is(err, os.ErrNotExist) && *save:
+			// First run: there is nothing to compare against yet.
+		case err != nil:
+			return fmt.Errorf("could not open file: %w", err)
+		default:
+			if err := json.Unmarshal(data, &previous); err != nil {
+				return fmt.Errorf("%s: %w", *baseline, err)
+			}
+		}
+	}
+
+	results := map[string]benchResult{}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "year\tday\tpart\tns/op\tallocs/op\tB/op\tΔ ns/op\tΔ allocs\tΔ B\t")
+	for _, p := range puzzles {
+		var data []byte
+		if *size > 0 {
+			data, err = p.NewInput(*seed, *size)
+		} else {
+			data, err = aoc.ReadInput(p.Input(aoc.InputFile))
+		}
+		if err != nil {
+			return err
+		}
+		for _, n := range parts {
+			solve, err := p.Part(n)
+			if err != nil {
+				return err
+			}
+			// testing.Benchmark has no way to report a failure, so make
+			// sure the solver works before timing it.
+			if _, err := p.Solve(n, data); err != nil {
+				return fmt.Errorf("%d day %02d part %d: %w", p.Year, p.Day, n, err)
+			}
+
+			r := testing.Benchmark(func(b *testing.B) {
+				aoctest.BenchSolver(b, solve, data)
+			})
+			res := benchResult{r.NsPerOp(), r.AllocsPerOp(), r.AllocedBytesPerOp()}
+			key := fmt.Sprintf("%d/%02d/%d", p.Year, p.Day, n)
+			if *size > 0 {
+				// Generated inputs are only comparable with the same ones.
+				key += fmt.Sprintf("/size=%d/seed=%d", *size, *seed)
+			}
+			results[key] = res
+
+			old, ok := previous[key]
+			fmt.Fprintf(w, "%d\t%02d\t%d\t%d\t%d\t%d\t%s\t%s\t%s\t\n",
+				p.Year, p.Day, n, res.NsPerOp, res.AllocsPerOp, res.BytesPerOp,
+				delta(old.NsPerOp, res.NsPerOp, ok),
+				delta(old.AllocsPerOp, res.AllocsPerOp, ok),
+				delta(old.BytesPerOp, res.BytesPerOp, ok))
+		}
+	}
+	w.Flush()
+
+	if *save {
+		// Keep entries for days and parts that were not benchmarked.
+		for key, res := range results {
+			previous[key] = res
+		}
+		return writeBaseline(*baseline, previous)
+	}
+	return nil
+}
+
+// delta formats the relative change from old to cur, or "-" when there is no
+// baseline entry to compare with.
+func delta(old, cur int64, ok bool) string {
+	switch {
+	case !ok:
+		return "-"
+	case old == 0 && cur == 0:
+		return "~"
+	case old == 0:
+		return "+inf"
+	}
+	return fmt.Sprintf("%+.1f%%", float64(cur-old)*100/float64(old))
+}
+
+func writeBaseline(filename string, results map[string]benchResult) error {
+	// encoding/json sorts map keys, so the file diffs cleanly between runs.
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, append(data, '\n'), 0o644)
+}
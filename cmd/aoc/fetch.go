@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"adventofcode/aoc"
+)
+
+// fetchCmd downloads a day's input into input.txt and the chosen example
+// blocks of its puzzle page into input2.txt, input3.txt and so on. Files
+// that already have content are left alone unless -force is given.
+func fetchCmd(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to fetch (1-25)")
+	examples := fs.String("examples", "1", "comma separated <pre><code> blocks of the puzzle page to save as examples, in order; empty for none")
+	list := fs.Bool("list", false, "print the puzzle page's code blocks, numbered, instead of saving anything")
+	force := fs.Bool("force", false, "overwrite files that already have content")
+	site := addSiteFlags(fs)
+	fs.Parse(args)
+
+	if *day == 0 {
+		return errors.New("-day is required")
+	}
+	puzzles, err := selectPuzzles(*year, *day)
+	if err != nil {
+		return fmt.Errorf("%w; %w", err, errNoDay)
+	}
+	p := puzzles[0]
+
+	c, err := site.client()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	blocks, err := c.Examples(ctx, p.Year, p.Day)
+	if err != nil {
+		return err
+	}
+	if *list {
+		for i, b := range blocks {
+			fmt.Printf("--- block %d ---\n%s\n", i+1, strings.TrimRight(b, "\n"))
+		}
+		return nil
+	}
+
+	input, err := c.Input(ctx, p.Year, p.Day)
+	if err != nil {
+		return err
+	}
+	if err := saveInput(p.Input(aoc.InputFile), input, *force); err != nil {
+		return err
+	}
+
+	if *examples == "" {
+		return nil
+	}
+	for n, field := range strings.Split(*examples, ",") {
+		i, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || i < 1 || i > len(blocks) {
+			return fmt.Errorf("-examples: no code block %q, the page has %d", field, len(blocks))
+		}
+		if err := saveInput(p.Input(aoc.ExampleFile(n+1)), []byte(blocks[i-1]), *force); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveInput writes data to path unless the file already has content.
+func saveInput(path string, data []byte, force bool) error {
+	if info, err := os.Stat(path); err == nil && info.Size() > 0 && !force {
+		fmt.Printf("kept %s (use -force to overwrite)\n", path)
+		return nil
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("wrote %s (%d bytes)\n", path, len(data))
+	return nil
+}
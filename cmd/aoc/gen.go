@@ -0,0 +1,46 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+)
+
+// genCmd writes a random input for a day, e.g. to feed a solver something
+// larger than the real input:
+//
+//	aoc gen -day 9 -size 2000 | aoc run -day 9 -input -
+func genCmd(args []string) error {
+	fs := flag.NewFlagSet("gen", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to generate an input for (1-25)")
+	seed := fs.Uint64("seed", 1, "seed of the random generator; the same seed and size give the same input")
+	size := fs.Int("size", 100, "size of the input, in the day's own unit (lines, grid side, ...)")
+	output := fs.String("o", "", "file to write (default: stdout)")
+	fs.Parse(args)
+
+	if *day == 0 {
+		return errors.New("-day is required")
+	}
+	puzzles, err := selectPuzzles(*year, *day)
+	if err != nil {
+		return err
+	}
+	p := puzzles[0]
+
+	data, err := p.NewInput(*seed, *size)
+	if err != nil {
+		return err
+	}
+	// A generated input the grammar rejects is a bug in the generator.
+	if errs := p.Check(data); len(errs) > 0 {
+		return fmt.Errorf("generated input does not match the grammar: %w", errors.Join(errs...))
+	}
+
+	if *output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(*output, data, 0o644)
+}
@@ -0,0 +1,72 @@
+// Command aoc runs the registered puzzle solutions.
+//
+// Usage:
+//
+//	aoc run -day 7 [-part 2] [-input path | -input - | -example 1]
+//	aoc run -all [-jobs 4] [-example 1] [-json] [-trace day07=verbose] [-validate=false]
+//	aoc verify [-day 7]
+//	aoc validate [-day 7] [-input path | -input - | -example 1]
+//	aoc bench [-day 7] [-part 2] [-size 1000 [-seed 1]] [-baseline bench.json [-save]]
+//	aoc gen -day 7 [-seed 1] [-size 100] [-o file]
+//	aoc new -day 13 [-year 2025]
+//	aoc fetch -day 7 [-year 2025] [-examples 1,3 | -list] [-force]
+//	aoc submit -day 7 -part 1 [-answer 42]
+//	aoc watch -day 7 [-input path | -example 1] [-interval 1s]
+//	aoc anim -day 4 [-input path | -example 1] [-o anim.gif | -o last.png] [-frames dir] [-scale 4] [-delay 10] [-palette '@=#ffff66']
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+)
+
+// commands maps a sub-command name to its implementation. Each command parses
+// its own flags from args.
+var commands = map[string]func(args []string) error{
+	"anim":     animCmd,
+	"bench":    benchCmd,
+	"fetch":    fetchCmd,
+	"gen":      genCmd,
+	"new":      newCmd,
+	"run":      runCmd,
+	"submit":   submitCmd,
+	"validate": validateCmd,
+	"verify":   verifyCmd,
+	"watch":    watchCmd,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "aoc: unknown command %q\n", name)
+		usage()
+		os.Exit(2)
+	}
+
+	if err := cmd(os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "aoc %s: %v\n", name, err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stderr, "usage: aoc <command> [flags]")
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s\n", name)
+	}
+	fmt.Fprintln(os.Stderr, "run 'aoc <command> -h' for the flags of a command")
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// dayFile is one file written by the new command.
+type dayFile struct {
+	name string
+	tmpl *template.Template
+}
+
+var dayFiles = []dayFile{
+	{"solution.go", template.Must(template.New("solution.go").Parse(solutionTemplate))},
+	{"generate.go", template.Must(template.New("generate.go").Parse(generateTemplate))},
+	{"solution_test.go", template.Must(template.New("solution_test.go").Parse(testTemplate))},
+	{"answers.json", template.Must(template.New("answers.json").Parse(answersTemplate))},
+	{"input2.txt", template.Must(template.New("input2.txt").Parse(""))},
+}
+
+const solutionTemplate = `package {{.Package}}
+
+import (
+	"{{.Module}}/aoc"
+	"{{.Module}}/aoc/grammar"
+)
+
+func init() {
+	aoc.Register(aoc.Puzzle{
+		Year:     {{.Year}},
+		Day:      {{.Day}},
+		Part1:    aoc.With(aoc.Lines, solvePart1),
+		Part2:    aoc.With(aoc.Lines, solvePart2),
+		Validate: inputGrammar.Check,
+		Generate: generate,
+	})
+}
+
+// inputGrammar accepts any input until the day's format is written down,
+// e.g. grammar.Lines(grammar.Each(grammar.Uint())) for a number per line.
+var inputGrammar = grammar.Lines(grammar.Each(grammar.Text()))
+
+// solvePart1 contains the logic for the first part of the puzzle.
+func solvePart1(lines []string) (int, error) {
+	total := 0
+	return total, nil
+}
+
+// solvePart2 contains the logic for the second part of the puzzle.
+// It often builds upon or modifies the logic from Part 1.
+func solvePart2(lines []string) (int, error) {
+	total := 0
+	return total, nil
+}
+`
+
+const generateTemplate = `package {{.Package}}
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+)
+
+// generate writes size lines of one random number each. Make it write the
+// day's format once inputGrammar describes it; FuzzGenerated then checks
+// that the solvers take every input it makes.
+func generate(r *rand.Rand, size int) []byte {
+	var b bytes.Buffer
+	for range size {
+		fmt.Fprintf(&b, "%d\n", r.IntN(1000))
+	}
+	return b.Bytes()
+}
+`
+
+const testTemplate = `package {{.Package}}
+
+import (
+	"testing"
+
+	"{{.Module}}/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, {{.Year}}, {{.Day}}, []aoctest.Example{
+		{Input: "input2.txt", Part: 1},
+		{Input: "input2.txt", Part: 2},
+	})
+}
+
+func FuzzGenerated(f *testing.F) {
+	aoctest.FuzzGenerated(f, {{.Year}}, {{.Day}}, 1, 100)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.Bench(b, {{.Year}}, {{.Day}}, 1, "input.txt")
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.Bench(b, {{.Year}}, {{.Day}}, 2, "input.txt")
+}
+`
+
+const answersTemplate = `{
+  "input.txt": {},
+  "input2.txt": {}
+}
+`
+
+// yearTemplate is the package of a new year directory, which imports its
+// days the way the runner's years.go imports the years.
+const yearTemplate = `// Package aoc{{.Year}} registers every day of the {{.Year}} puzzle set. Every day
+// registers its solvers with aoc.Register from an init function, so
+// importing the package is all the runner needs.
+package aoc{{.Year}}
+
+import (
+	_ "{{.Module}}/{{.Year}}/{{.Package}}"
+)
+`
+
+// yearsFile is the runner file importing every year, relative to the
+// module root.
+var yearsFile = filepath.Join("cmd", "aoc", "years.go")
+
+// newCmd scaffolds the directory for a new day from the standard template
+// and registers it with the runner, starting the year's directory if this
+// is its first day.
+func newCmd(args []string) error {
+	fs := flag.NewFlagSet("new", flag.ExitOnError)
+	year := fs.Int("year", latestYear(), "puzzle year")
+	day := fs.Int("day", 0, "day to create (1-25)")
+	dir := fs.String("dir", ".", "module root to create the day in")
+	fs.Parse(args)
+
+	if *day < 1 || *day > 25 {
+		return fmt.Errorf("-day must be between 1 and 25, got %d", *day)
+	}
+
+	module, err := modulePath(*dir)
+	if err != nil {
+		return err
+	}
+	data := struct {
+		Module, Package string
+		Year, Day       int
+	}{module, fmt.Sprintf("day%02d", *day), *year, *day}
+
+	yearDir := filepath.Join(*dir, strconv.Itoa(data.Year))
+	dayDir := filepath.Join(yearDir, data.Package)
+	if _, err := os.Stat(dayDir); err == nil {
+		return fmt.Errorf("%s already exists", dayDir)
+	}
+	if err := os.MkdirAll(dayDir, 0o755); err != nil {
+		return err
+	}
+
+	for _, f := range dayFiles {
+		var buf bytes.Buffer
+		if err := f.tmpl.Execute(&buf, data); err != nil {
+			return err
+		}
+		content := buf.Bytes()
+		if strings.HasSuffix(f.name, ".go") {
+			if content, err = format.Source(content); err != nil {
+				return fmt.Errorf("%s: %w", f.name, err)
+			}
+		}
+		if err := os.WriteFile(filepath.Join(dayDir, f.name), content, 0o644); err != nil {
+			return err
+		}
+		fmt.Println("created", filepath.Join(dayDir, f.name))
+	}
+
+	daysFile := filepath.Join(yearDir, "days.go")
+	if _, err := os.Stat(daysFile); errors.Is(err, os.ErrNotExist) {
+		var buf bytes.Buffer
+		if err := template.Must(template.New("days.go").Parse(yearTemplate)).Execute(&buf, data); err != nil {
+			return err
+		}
+		if err := os.WriteFile(daysFile, buf.Bytes(), 0o644); err != nil {
+			return err
+		}
+		fmt.Println("created", daysFile)
+
+		importPath := fmt.Sprintf("%s/%d", module, data.Year)
+		if err := addImport(filepath.Join(*dir, yearsFile), importPath); err != nil {
+			return err
+		}
+		fmt.Printf("registered %s in %s\n", importPath, filepath.Join(*dir, yearsFile))
+		return nil
+	}
+
+	importPath := fmt.Sprintf("%s/%d/%s", module, data.Year, data.Package)
+	if err := addImport(daysFile, importPath); err != nil {
+		return err
+	}
+	fmt.Printf("registered %s in %s\n", importPath, daysFile)
+	return nil
+}
+
+// modulePath reads the module path from the go.mod file in dir.
+func modulePath(dir string) (string, error) {
+	file, err := os.Open(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return "", fmt.Errorf("could not open file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if module, ok := strings.CutPrefix(scanner.Text(), "module "); ok {
+			return strings.TrimSpace(module), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", errors.New("go.mod has no module line")
+}
+
+// addImport adds a blank import of importPath to the import block of a
+// year's days.go or the runner's years.go, keeping the block sorted.
+func addImport(filename, importPath string) error {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("could not open file: %w", err)
+	}
+
+	lines := strings.Split(string(src), "\n")
+	start, end := -1, -1
+	for i, line := range lines {
+		if line == "import (" {
+			start = i
+		} else if start >= 0 && line == ")" {
+			end = i
+			break
+		}
+	}
+	if start < 0 || end < 0 {
+		return fmt.Errorf("%s: no import block found", filename)
+	}
+
+	imports := append([]string{}, lines[start+1:end]...)
+	entry := fmt.Sprintf("\t_ %q", importPath)
+	for _, line := range imports {
+		if line == entry {
+			return nil
+		}
+	}
+	imports = append(imports, entry)
+	sort.Strings(imports)
+
+	out := append(append(append([]string{}, lines[:start+1]...), imports...), lines[end:]...)
+	formatted, err := format.Source([]byte(strings.Join(out, "\n")))
+	if err != nil {
+		return fmt.Errorf("%s: %w", filename, err)
+	}
+	return os.WriteFile(filename, formatted, 0o644)
+}
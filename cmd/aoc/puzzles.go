@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"adventofcode/aoc"
+)
+
+// yearFlag defines the -year flag shared by the commands that take -day.
+func yearFlag(fs *flag.FlagSet) *int {
+	return fs.Int("year", 0, "puzzle year (default: the latest year with -day, every year without)")
+}
+
+// latestYear returns the most recent year with a registered puzzle.
+func latestYear() int {
+	years := aoc.Years()
+	if len(years) == 0 {
+		return 0
+	}
+	return years[len(years)-1]
+}
+
+// selectPuzzles returns the puzzles a command works on: the given day, or
+// every day of year when day is 0. Year 0 stands for the latest year when a
+// day is given and for every year otherwise.
+func selectPuzzles(year, day int) ([]aoc.Puzzle, error) {
+	if day != 0 {
+		if year == 0 {
+			year = latestYear()
+		}
+		p, ok := aoc.Lookup(year, day)
+		if !ok {
+			return nil, fmt.Errorf("no solution registered for %d day %02d", year, day)
+		}
+		return []aoc.Puzzle{p}, nil
+	}
+
+	var puzzles []aoc.Puzzle
+	for _, p := range aoc.Puzzles() {
+		if year == 0 || p.Year == year {
+			puzzles = append(puzzles, p)
+		}
+	}
+	if len(puzzles) == 0 {
+		return nil, fmt.Errorf("no solutions registered for %d", year)
+	}
+	return puzzles, nil
+}
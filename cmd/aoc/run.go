@@ -0,0 +1,336 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/trace"
+)
+
+func runCmd(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to run (1-25)")
+	part := fs.Int("part", 0, "part to run (1 or 2); both parts when 0")
+	input := fs.String("input", "", "input file, or - for stdin (default: input.txt in the day's directory)")
+	example := fs.Int("example", 0, "run the day's nth example instead (1 is input2.txt, 2 is input3.txt)")
+	all := fs.Bool("all", false, "run every registered day of -year")
+	jsonOut := fs.Bool("json", false, "print the run as one JSON object instead of text (an array of them with -all)")
+	traceSpec := fs.String("trace", os.Getenv(trace.EnvVar), "debug trace topics for stderr, e.g. day07 or day10=verbose,all=info")
+	validate := fs.Bool("validate", true, "check the input against the day's grammar before solving")
+	jobs := fs.Int("jobs", runtime.GOMAXPROCS(0), "number of parts to solve at the same time")
+	fs.Parse(args)
+
+	if err := trace.Configure(*traceSpec); err != nil {
+		return err
+	}
+	if *jobs < 1 {
+		return fmt.Errorf("-jobs must be at least 1, got %d", *jobs)
+	}
+
+	if *input != "" && *example != 0 {
+		return errors.New("-input and -example are mutually exclusive")
+	}
+
+	if *all {
+		if *day != 0 || *input != "" {
+			return errors.New("-all cannot be combined with -day or -input")
+		}
+	} else if *day == 0 {
+		return errors.New("-day or -all is required")
+	}
+	puzzles, err := selectPuzzles(*year, *day)
+	if err != nil {
+		return err
+	}
+
+	parts := []int{1, 2}
+	switch *part {
+	case 0:
+	case 1, 2:
+		parts = []int{*part}
+	default:
+		return fmt.Errorf("-part must be 1 or 2, got %d", *part)
+	}
+
+	// Read and check every input first, then solve the parts on a pool of
+	// workers. Results are printed in day order once all are in.
+	var tasks []task
+	for _, p := range puzzles {
+		path := *input
+		switch {
+		case *example > 0:
+			path = p.Input(aoc.ExampleFile(*example))
+		case path == "":
+			path = p.Input(aoc.InputFile)
+		}
+		data, err := aoc.ReadInput(path)
+		if errors.Is(err, os.ErrNotExist) && *all && *example > 0 {
+			// Not every day has that many examples.
+			continue
+		}
+		if err != nil {
+			err = fmt.Errorf("reading input: %w", err)
+		}
+		if err == nil && *validate {
+			err = checkInput(p, path, data)
+		}
+		for _, n := range parts {
+			tasks = append(tasks, task{p, n, path, data, err})
+		}
+	}
+
+	start := time.Now()
+	results := solveAll(tasks, *jobs)
+	wall := time.Since(start)
+
+	out := newReporter(os.Stdout, *jsonOut, *all)
+	failed := false
+	for i, r := range results {
+		if i == 0 || r.Year != results[i-1].Year || r.Day != results[i-1].Day {
+			out.day(tasks[i].puzzle)
+		}
+		if r.Error != "" {
+			failed = true
+		}
+		out.part(r)
+	}
+	out.summary(results, wall, min(*jobs, len(tasks)))
+	if failed {
+		return errors.New("some parts failed")
+	}
+	return nil
+}
+
+// task is one part to solve. An input that could not be read or failed
+// its check carries the error instead of being solved.
+type task struct {
+	puzzle aoc.Puzzle
+	part   int
+	path   string
+	data   []byte
+	err    error
+}
+
+// solveAll solves the tasks on up to jobs goroutines and returns the
+// results in task order.
+func solveAll(tasks []task, jobs int) []result {
+	results := make([]result, len(tasks))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for range max(min(jobs, len(tasks)), 1) {
+		wg.Go(func() {
+			for i := range next {
+				t := tasks[i]
+				if t.err != nil {
+					results[i] = result{Year: t.puzzle.Year, Day: t.puzzle.Day, Part: t.part, Input: t.path, Error: t.err.Error()}
+					continue
+				}
+				results[i] = solvePart(t.puzzle, t.part, t.path, t.data)
+			}
+		})
+	}
+	for i := range tasks {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+	return results
+}
+
+// result is the outcome of solving one part.
+type result struct {
+	Year, Day, Part int
+	Answer          string
+	Error           string
+	ElapsedNs       int64
+	Input           string
+	InputHash       string
+}
+
+// dayResult is what -json prints for a day: its input and the parts solved
+// against it. Answers are strings so that large values survive any JSON
+// reader.
+type dayResult struct {
+	Year      int          `json:"year"`
+	Day       int          `json:"day"`
+	Input     string       `json:"input"`
+	InputHash string       `json:"input_sha256"`
+	Parts     []partResult `json:"parts"`
+}
+
+type partResult struct {
+	Part      int    `json:"part"`
+	Answer    string `json:"answer"`
+	Error     string `json:"error,omitempty"`
+	ElapsedNs int64  `json:"elapsed_ns"`
+}
+
+// checkInput runs the day's grammar over its input so that a malformed
+// input is reported line by line instead of reaching the solvers. The
+// deviations go to stderr, the returned error summarises them.
+func checkInput(p aoc.Puzzle, path string, data []byte) error {
+	errs := p.Check(data)
+	if len(errs) == 0 {
+		return nil
+	}
+	for _, err := range errs {
+		fmt.Fprintln(os.Stderr, aoc.InFile(err, path))
+	}
+	return fmt.Errorf("input does not match the day's grammar: %s (see aoc validate)", problemCount(len(errs)))
+}
+
+// solvePart solves one part of p against input and times it. A panic in
+// the solver is reported as the part's error, with the line that panicked,
+// rather than ending the run.
+func solvePart(p aoc.Puzzle, n int, path string, input []byte) (r result) {
+	sum := sha256.Sum256(input)
+	r = result{
+		Year:      p.Year,
+		Day:       p.Day,
+		Part:      n,
+		Input:     path,
+		InputHash: hex.EncodeToString(sum[:]),
+	}
+
+	start := time.Now()
+	defer func() {
+		if v := recover(); v != nil {
+			r.ElapsedNs = time.Since(start).Nanoseconds()
+			r.Error = fmt.Sprintf("panic: %v%s", v, panicSite())
+		}
+	}()
+	answer, err := p.Solve(n, input)
+	r.ElapsedNs = time.Since(start).Nanoseconds()
+
+	if err != nil {
+		r.Error = aoc.InFile(err, path).Error()
+	} else {
+		r.Answer = fmt.Sprint(answer)
+	}
+	return r
+}
+
+// panicSite returns " at file:line" for the code that panicked, called from
+// the deferred function that recovered. The frames below it are the
+// runtime's panic machinery.
+func panicSite() string {
+	pc := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(3, pc)])
+	for {
+		f, more := frames.Next()
+		if !strings.HasPrefix(f.Function, "runtime.") {
+			return fmt.Sprintf(" at %s:%d", f.File, f.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// reporter prints results either as the classic text banner and
+// "Part N Result" lines, as a table when running every day, or as JSON:
+// a single dayResult, or an array of them when running every day, written
+// once the run is over.
+type reporter struct {
+	w     io.Writer
+	json  bool
+	all   bool
+	days  *[]dayResult
+	table *tabwriter.Writer
+}
+
+func newReporter(w io.Writer, jsonOut, all bool) reporter {
+	switch {
+	case jsonOut:
+		return reporter{w: w, json: true, all: all, days: &[]dayResult{}}
+	case all:
+		t := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(t, "year\tday\tpart\ttime\tanswer")
+		return reporter{w: w, table: t}
+	}
+	return reporter{w: w}
+}
+
+func (rep reporter) day(p aoc.Puzzle) {
+	switch {
+	case rep.json:
+		*rep.days = append(*rep.days, dayResult{Year: p.Year, Day: p.Day, Parts: []partResult{}})
+	case rep.table == nil:
+		fmt.Fprintf(rep.w, "--- Advent of Code %d - Day %02d ---\n", p.Year, p.Day)
+	}
+}
+
+func (rep reporter) part(r result) {
+	switch {
+	case rep.json:
+		d := &(*rep.days)[len(*rep.days)-1]
+		d.Input = r.Input
+		if r.InputHash != "" {
+			d.InputHash = r.InputHash
+		}
+		d.Parts = append(d.Parts, partResult{Part: r.Part, Answer: r.Answer, Error: r.Error, ElapsedNs: r.ElapsedNs})
+	case rep.table != nil:
+		answer := r.Answer
+		if r.Error != "" {
+			answer = "ERROR " + r.Error
+		}
+		fmt.Fprintf(rep.table, "%d\t%02d\t%d\t%v\t%s\n", r.Year, r.Day, r.Part, elapsed(r.ElapsedNs), answer)
+	case r.Error != "":
+		fmt.Fprintf(os.Stderr, "Part %d Error: %s\n", r.Part, r.Error)
+	default:
+		fmt.Fprintf(rep.w, "Part %d Result: %s\n", r.Part, r.Answer)
+	}
+}
+
+// summary writes the JSON, or ends the table with the wall-clock time of
+// the run against the time the parts took added up, which shows what
+// running them side by side saved.
+func (rep reporter) summary(results []result, wall time.Duration, workers int) {
+	if rep.json {
+		enc := json.NewEncoder(rep.w)
+		if rep.all {
+			enc.Encode(*rep.days)
+		} else if len(*rep.days) > 0 {
+			enc.Encode((*rep.days)[0])
+		}
+		return
+	}
+	if rep.table == nil {
+		return
+	}
+	rep.table.Flush()
+	var summed int64
+	failed := 0
+	for _, r := range results {
+		summed += r.ElapsedNs
+		if r.Error != "" {
+			failed++
+		}
+	}
+	fmt.Fprintf(rep.w, "%d parts, %d failed: %v wall, %v summed on %s\n",
+		len(results), failed, wall.Round(time.Microsecond), elapsed(summed), workerCount(workers))
+}
+
+func elapsed(ns int64) time.Duration {
+	return time.Duration(ns).Round(time.Microsecond)
+}
+
+func workerCount(n int) string {
+	if n == 1 {
+		return "1 worker"
+	}
+	return fmt.Sprintf("%d workers", n)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+
+	"adventofcode/aoc"
+)
+
+func TestSolveAllIsolatesPanics(t *testing.T) {
+	p := aoc.Puzzle{
+		Year: 2025,
+		Day:  6,
+		Part1: func(r io.Reader) (any, error) {
+			var line []byte
+			return line[3], nil
+		},
+		Part2: func(r io.Reader) (any, error) { return 42, nil },
+	}
+	tasks := []task{
+		{puzzle: p, part: 1, path: "input.txt"},
+		{puzzle: p, part: 2, path: "input.txt"},
+		{puzzle: p, part: 2, path: "missing.txt", err: errors.New("reading input: no such file")},
+	}
+
+	results := solveAll(tasks, 2)
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	if err := results[0].Error; !strings.HasPrefix(err, "panic: runtime error: index out of range") || !strings.Contains(err, "run_test.go:") {
+		t.Errorf("part 1: got error %q, want the panic and where it happened", err)
+	}
+	if results[1].Error != "" || results[1].Answer != "42" {
+		t.Errorf("part 2: got %+v, want answer 42", results[1])
+	}
+	if results[2].Error != "reading input: no such file" {
+		t.Errorf("unread input: got error %q", results[2].Error)
+	}
+}
+
+func TestSolveAllKeepsBigAnswers(t *testing.T) {
+	const huge = "198328646122499229585272282111041482133759486956150525407635901190049824961022576438789251683"
+	p := aoc.Puzzle{
+		Year: 2025,
+		Day:  7,
+		Part2: func(r io.Reader) (any, error) {
+			n, _ := new(big.Int).SetString(huge, 10)
+			return n, nil
+		},
+	}
+	results := solveAll([]task{{puzzle: p, part: 2, path: "input.txt"}}, 1)
+	if results[0].Error != "" || results[0].Answer != huge {
+		t.Errorf("got %+v, want every digit of the answer", results[0])
+	}
+}
+
+func TestReporterJSON(t *testing.T) {
+	days := []aoc.Puzzle{{Year: 2025, Day: 1}, {Year: 2025, Day: 2}}
+	results := []result{
+		{Year: 2025, Day: 1, Part: 1, Answer: "3", ElapsedNs: 10, Input: "a.txt", InputHash: "ab"},
+		{Year: 2025, Day: 1, Part: 2, Answer: "6", ElapsedNs: 20, Input: "a.txt", InputHash: "ab"},
+		{Year: 2025, Day: 2, Part: 1, Error: "reading input: no such file", Input: "b.txt"},
+	}
+	report := func(all bool, n int) string {
+		var buf bytes.Buffer
+		rep := newReporter(&buf, true, all)
+		for i, r := range results[:n] {
+			if i == 0 || r.Day != results[i-1].Day {
+				rep.day(days[r.Day-1])
+			}
+			rep.part(r)
+		}
+		rep.summary(results[:n], 0, 1)
+		return buf.String()
+	}
+
+	// One day is a single object, whatever its number of parts.
+	var day dayResult
+	out := report(false, 2)
+	if err := json.Unmarshal([]byte(out), &day); err != nil || strings.Count(out, "\n") != 1 {
+		t.Fatalf("one day: %q, %v", out, err)
+	}
+	if day.Year != 2025 || day.Day != 1 || day.Input != "a.txt" || day.InputHash != "ab" ||
+		len(day.Parts) != 2 || day.Parts[0] != (partResult{Part: 1, Answer: "3", ElapsedNs: 10}) || day.Parts[1].Answer != "6" {
+		t.Errorf("one day: got %+v", day)
+	}
+
+	var all []dayResult
+	out = report(true, 3)
+	if err := json.Unmarshal([]byte(out), &all); err != nil || strings.Count(out, "\n") != 1 {
+		t.Fatalf("-all: %q, %v", out, err)
+	}
+	if len(all) != 2 || len(all[0].Parts) != 2 || all[1].Input != "b.txt" || all[1].Parts[0].Error == "" {
+		t.Errorf("-all: got %+v", all)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"adventofcode/aoc/client"
+)
+
+// siteFlags are the flags fetch and submit share to reach the puzzle site.
+type siteFlags struct {
+	baseURL  *string
+	cacheDir *string
+}
+
+func addSiteFlags(fs *flag.FlagSet) siteFlags {
+	baseURL := os.Getenv(client.BaseURLEnvVar)
+	if baseURL == "" {
+		baseURL = client.DefaultBaseURL
+	}
+	cacheDir := ""
+	if dir, err := os.UserCacheDir(); err == nil {
+		cacheDir = filepath.Join(dir, "adventofcode")
+	}
+	return siteFlags{
+		baseURL:  fs.String("base-url", baseURL, "puzzle site to talk to (env "+client.BaseURLEnvVar+")"),
+		cacheDir: fs.String("cache", cacheDir, "directory for downloaded inputs and pages; empty disables the cache"),
+	}
+}
+
+// client returns a client for the site, logged in with the session cookie
+// from the environment.
+func (f siteFlags) client() (*client.Client, error) {
+	session := os.Getenv(client.SessionEnvVar)
+	if session == "" {
+		return nil, fmt.Errorf("set %s to the value of the site's session cookie", client.SessionEnvVar)
+	}
+	return client.New(*f.baseURL, session, *f.cacheDir), nil
+}
+
+// errNoDay is returned by the site commands for a day that is not
+// registered yet; they write into the day's directory.
+var errNoDay = errors.New("create the day with aoc new first")
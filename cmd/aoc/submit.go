@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+
+	"adventofcode/aoc"
+	"adventofcode/aoc/client"
+)
+
+// submitCmd sends an answer for the real input and records the response in
+// the day's answers.json: a right answer becomes the accepted answer, a
+// wrong one is kept with its hint so that it is never sent again.
+func submitCmd(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to submit (1-25)")
+	part := fs.Int("part", 0, "part to submit (1 or 2)")
+	answer := fs.String("answer", "", "answer to send (default: solve the part on input.txt)")
+	site := addSiteFlags(fs)
+	fs.Parse(args)
+
+	if *day == 0 {
+		return errors.New("-day is required")
+	}
+	if *part != 1 && *part != 2 {
+		return fmt.Errorf("-part must be 1 or 2, got %d", *part)
+	}
+	puzzles, err := selectPuzzles(*year, *day)
+	if err != nil {
+		return fmt.Errorf("%w; %w", err, errNoDay)
+	}
+	p := puzzles[0]
+
+	if *answer == "" {
+		got, err := p.SolveFile(*part, p.Input(aoc.InputFile))
+		if err != nil {
+			return err
+		}
+		*answer = fmt.Sprint(got)
+	}
+
+	ledger := p.Input(aoc.AnswersFile)
+	answers, err := aoc.ReadAnswers(ledger)
+	if errors.Is(err, os.ErrNotExist) {
+		answers = aoc.Answers{}
+	} else if err != nil {
+		return err
+	}
+	if err := checkSubmission(answers[aoc.InputFile], *part, *answer); err != nil {
+		return err
+	}
+
+	c, err := site.client()
+	if err != nil {
+		return err
+	}
+	res, err := c.Submit(context.Background(), p.Year, p.Day, *part, *answer)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d day %02d part %d: %s: %s\n", p.Year, p.Day, *part, *answer, res.Verdict)
+	fmt.Println(res.Message)
+
+	switch res.Verdict {
+	case client.Correct:
+		answers.Accept(aoc.InputFile, *part, *answer)
+	case client.Incorrect:
+		answers.Reject(aoc.InputFile, *part, *answer, res.Hint)
+	default:
+		return fmt.Errorf("answer not checked: %s", res.Verdict)
+	}
+	if err := aoc.WriteAnswers(ledger, answers); err != nil {
+		return err
+	}
+	fmt.Println("recorded in", ledger)
+
+	if res.Verdict != client.Correct {
+		return errors.New("wrong answer")
+	}
+	return nil
+}
+
+// checkSubmission refuses an answer the ledger already rules out: the part
+// is solved, the answer was rejected before, or it is on the wrong side of
+// a rejected "too high" or "too low" answer.
+func checkSubmission(pa aoc.PartAnswers, part int, answer string) error {
+	if accepted := pa.Part(part); accepted != "" {
+		return fmt.Errorf("part %d is already solved with %s", part, accepted)
+	}
+	n, numeric := new(big.Int).SetString(answer, 10)
+	for _, r := range pa.RejectedPart(part) {
+		if r.Answer == answer {
+			return fmt.Errorf("%s was already rejected", answer)
+		}
+		bound, ok := new(big.Int).SetString(r.Answer, 10)
+		if !numeric || !ok {
+			continue
+		}
+		if r.Hint == "too high" && n.Cmp(bound) >= 0 || r.Hint == "too low" && n.Cmp(bound) <= 0 {
+			return fmt.Errorf("%s cannot be right, %s was %s", answer, r.Answer, r.Hint)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+
+	"adventofcode/aoc"
+)
+
+func TestCheckSubmission(t *testing.T) {
+	ledger := aoc.PartAnswers{
+		Part1: "17",
+		Rejected2: []aoc.Rejected{
+			{Answer: "500", Hint: "too high"},
+			{Answer: "100", Hint: "too low"},
+			{Answer: "250"},
+			{Answer: "abc"},
+		},
+	}
+	tests := []struct {
+		part   int
+		answer string
+		ok     bool
+	}{
+		{1, "18", false},  // already solved
+		{1, "17", false},  // already solved, even with the same answer
+		{2, "250", false}, // rejected before
+		{2, "abc", false}, // rejected before, not a number
+		{2, "500", false}, // too high
+		{2, "501", false}, // above a too high answer
+		{2, "100", false}, // too low
+		{2, "99", false},  // below a too low answer
+		{2, "-7", false},
+		{2, "99999999999999999999999", false}, // compared beyond int64
+		{2, "101", true},
+		{2, "499", true},
+		{2, "251", true},
+		{2, "xyz", true}, // not a number, so no bound applies
+	}
+	for _, tt := range tests {
+		err := checkSubmission(ledger, tt.part, tt.answer)
+		if tt.ok && err != nil {
+			t.Errorf("part %d answer %s: %v", tt.part, tt.answer, err)
+		} else if !tt.ok && err == nil {
+			t.Errorf("part %d answer %s was let through", tt.part, tt.answer)
+		}
+	}
+	if err := checkSubmission(aoc.PartAnswers{}, 1, "1"); err != nil {
+		t.Errorf("empty ledger: %v", err)
+	}
+}
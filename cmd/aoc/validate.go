@@ -0,0 +1,87 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"path/filepath"
+
+	"adventofcode/aoc"
+)
+
+// validateCmd checks inputs against the grammars the days declare, without
+// running any solver, and prints every deviation as file:line:col.
+func validateCmd(args []string) error {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to check; every registered day when 0")
+	input := fs.String("input", "", "input file to check, or - for stdin (default: every input*.txt in the day's directory)")
+	example := fs.Int("example", 0, "check only the day's nth example (1 is input2.txt, 2 is input3.txt)")
+	fs.Parse(args)
+
+	if *input != "" && *example != 0 {
+		return errors.New("-input and -example are mutually exclusive")
+	}
+
+	if *day == 0 && *input != "" {
+		return errors.New("-input needs -day")
+	}
+	puzzles, err := selectPuzzles(*year, *day)
+	if err != nil {
+		return err
+	}
+
+	var checked, problems int
+	for _, p := range puzzles {
+		if p.Validate == nil {
+			fmt.Printf("%d day %02d has no input grammar\n", p.Year, p.Day)
+			continue
+		}
+
+		var paths []string
+		switch {
+		case *input != "":
+			paths = []string{*input}
+		case *example > 0:
+			paths = []string{p.Input(aoc.ExampleFile(*example))}
+		default:
+			var err error
+			if paths, err = filepath.Glob(p.Input("input*.txt")); err != nil {
+				return err
+			}
+		}
+
+		for _, path := range paths {
+			data, err := aoc.ReadInput(path)
+			if err != nil {
+				return err
+			}
+			checked++
+			errs := p.Check(data)
+			for _, err := range errs {
+				fmt.Println(aoc.InFile(err, path))
+			}
+			problems += len(errs)
+
+			label := fmt.Sprintf("%d day %02d %-10s", p.Year, p.Day, filepath.Base(path))
+			if len(errs) == 0 {
+				fmt.Printf("%s  OK\n", label)
+			} else {
+				fmt.Printf("%s  %s\n", label, problemCount(len(errs)))
+			}
+		}
+	}
+
+	fmt.Printf("%d inputs checked, %s\n", checked, problemCount(problems))
+	if problems > 0 {
+		return fmt.Errorf("%s found", problemCount(problems))
+	}
+	return nil
+}
+
+func problemCount(n int) string {
+	if n == 1 {
+		return "1 problem"
+	}
+	return fmt.Sprintf("%d problems", n)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"adventofcode/aoc"
+)
+
+// verifyCmd re-runs the solvers against every input with a recorded answer
+// and reports whether the answers still match the ledger in answers.json.
+func verifyCmd(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to verify; every registered day when 0")
+	fs.Parse(args)
+
+	puzzles, err := selectPuzzles(*year, *day)
+	if err != nil {
+		return err
+	}
+
+	var pass, fail, missing int
+	for _, p := range puzzles {
+		answers, err := aoc.ReadAnswers(p.Input(aoc.AnswersFile))
+		if errors.Is(err, os.ErrNotExist) {
+			answers = aoc.Answers{}
+		} else if err != nil {
+			return err
+		}
+
+		for _, c := range verifyChecks(answers) {
+			want := answers[c.input].Part(c.part)
+			label := fmt.Sprintf("%d day %02d %-10s part %d", p.Year, p.Day, c.input, c.part)
+
+			// A new day has no input until it is fetched, which is not a
+			// failure of its solvers.
+			if _, err := os.Stat(p.Input(c.input)); c.input == aoc.InputFile && errors.Is(err, os.ErrNotExist) {
+				missing++
+				fmt.Printf("%s  MISSING  no %s; run aoc fetch\n", label, c.input)
+				continue
+			}
+			got, err := p.SolveFile(c.part, p.Input(c.input))
+			switch {
+			case err != nil:
+				fail++
+				fmt.Printf("%s  FAIL     error: %v\n", label, err)
+			case want == "":
+				missing++
+				fmt.Printf("%s  MISSING  got %v\n", label, got)
+			case fmt.Sprint(got) != want:
+				fail++
+				fmt.Printf("%s  FAIL     got %v, want %s\n", label, got, want)
+			default:
+				pass++
+				fmt.Printf("%s  PASS     %s\n", label, want)
+			}
+		}
+	}
+
+	fmt.Printf("%d passed, %d failed, %d missing\n", pass, fail, missing)
+	if fail > 0 {
+		return fmt.Errorf("%d answers do not match %s", fail, aoc.AnswersFile)
+	}
+	return nil
+}
+
+type verifyCheck struct {
+	input string
+	part  int
+}
+
+// verifyChecks lists what verify runs for one day: both parts of the real
+// input, plus each part recorded for the other inputs. Examples often
+// only apply to one part, so unrecorded example parts are not reported.
+func verifyChecks(answers aoc.Answers) []verifyCheck {
+	inputs := []string{aoc.InputFile}
+	for name := range answers {
+		if name != aoc.InputFile {
+			inputs = append(inputs, name)
+		}
+	}
+	sort.Strings(inputs[1:])
+
+	var checks []verifyCheck
+	for _, name := range inputs {
+		for n := 1; n <= 2; n++ {
+			if name == aoc.InputFile || answers[name].Part(n) != "" {
+				checks = append(checks, verifyCheck{name, n})
+			}
+		}
+	}
+	return checks
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// watchCmd polls a day's directory and, whenever a Go file or an input
+// changes, rebuilds the runner and solves both parts again, showing how the
+// answers moved and which example checks fail. Polling keeps it to the
+// standard library, so it works on any machine.
+func watchCmd(args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to watch (1-25)")
+	input := fs.String("input", "", "input file (default: input.txt in the day's directory)")
+	example := fs.Int("example", 0, "solve the day's nth example instead (1 is input2.txt, 2 is input3.txt)")
+	interval := fs.Duration("interval", time.Second, "how often to look for changes")
+	fs.Parse(args)
+
+	if *day == 0 {
+		return errors.New("-day is required")
+	}
+	if *input != "" && *example != 0 {
+		return errors.New("-input and -example are mutually exclusive")
+	}
+	puzzles, err := selectPuzzles(*year, *day)
+	if err != nil {
+		return err
+	}
+	p := puzzles[0]
+	root, err := moduleRoot(p.Dir)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.MkdirTemp("", "aoc-watch")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	w := &watcher{
+		root: root,
+		bin:  filepath.Join(tmp, "aoc"),
+		args: []string{"-year", strconv.Itoa(p.Year), "-day", strconv.Itoa(p.Day)},
+	}
+	runArgs := append([]string{"run", "-json"}, w.args...)
+	switch {
+	case *input != "":
+		abs, err := filepath.Abs(*input)
+		if err != nil {
+			return err
+		}
+		runArgs = append(runArgs, "-input", abs)
+	case *example > 0:
+		runArgs = append(runArgs, "-example", strconv.Itoa(*example))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Printf("watching %s, Ctrl-C to stop\n", p.Dir)
+	var seen map[string]stamp
+	for {
+		now, err := stamps(p.Dir)
+		if err != nil {
+			return err
+		}
+		if changed := changedFiles(seen, now); len(changed) > 0 {
+			fmt.Printf("\n=== %s %s ===\n", time.Now().Format(time.TimeOnly), strings.Join(changed, ", "))
+			w.cycle(ctx, runArgs)
+		}
+		seen = now
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(*interval):
+		}
+	}
+}
+
+// watcher rebuilds and runs the runner for one day.
+type watcher struct {
+	root string   // module root, where the runner is built
+	bin  string   // where the rebuilt runner goes
+	args []string // -year and -day
+	last map[int]string
+}
+
+// cycle rebuilds the runner, solves both parts and checks the recorded
+// answers, printing what it finds. Failures are shown, not returned: the
+// next edit may fix them.
+func (w *watcher) cycle(ctx context.Context, runArgs []string) {
+	build := exec.CommandContext(ctx, "go", "build", "-o", w.bin, "./cmd/aoc")
+	build.Dir = w.root
+	if out, err := build.CombinedOutput(); err != nil {
+		fmt.Printf("build failed:\n%s", out)
+		return
+	}
+
+	var stdout, stderr bytes.Buffer
+	run := exec.CommandContext(ctx, w.bin, runArgs...)
+	run.Stdout, run.Stderr = &stdout, &stderr
+	run.Run()
+	answers := map[int]string{}
+	// Output that does not decode leaves no parts, and stderr is shown.
+	var day dayResult
+	json.Unmarshal(stdout.Bytes(), &day)
+	for _, r := range day.Parts {
+		if r.Error != "" {
+			fmt.Printf("Part %d: error: %s\n", r.Part, r.Error)
+			continue
+		}
+		answers[r.Part] = r.Answer
+		fmt.Printf("Part %d: %s%s  (%v)\n", r.Part, r.Answer, answerChange(w.last, r.Part, r.Answer), elapsed(r.ElapsedNs))
+	}
+	if len(answers) == 0 {
+		// Nothing was solved; show why, e.g. an input the grammar rejects.
+		fmt.Print(stderr.String())
+	}
+	w.last = answers
+
+	// verify checks every answer recorded in answers.json, which covers
+	// the examples; only its failures are worth a line here.
+	stdout.Reset()
+	verify := exec.CommandContext(ctx, w.bin, append([]string{"verify"}, w.args...)...)
+	verify.Stdout = &stdout
+	verify.Run()
+	var failing []string
+	summary := ""
+	sc := bufio.NewScanner(&stdout)
+	for sc.Scan() {
+		line := sc.Text()
+		switch {
+		case strings.Contains(line, "  FAIL  "):
+			failing = append(failing, line)
+		case strings.HasSuffix(line, " missing"):
+			summary = line
+		}
+	}
+	fmt.Printf("checks: %s\n", summary)
+	for _, line := range failing {
+		fmt.Println("  " + line)
+	}
+}
+
+// answerChange describes how answer differs from the previous run's.
+func answerChange(last map[int]string, part int, answer string) string {
+	prev, ok := last[part]
+	switch {
+	case last == nil:
+		return ""
+	case !ok:
+		return " (new)"
+	case prev == answer:
+		return " (unchanged)"
+	}
+	return fmt.Sprintf(" (was %s)", prev)
+}
+
+// stamp is what polling compares to notice a changed file.
+type stamp struct {
+	mod  int64 // modification time in nanoseconds
+	size int64
+}
+
+// stamps returns the stamp of every Go file and input in dir.
+func stamps(dir string) (map[string]stamp, error) {
+	files := map[string]stamp{}
+	for _, pattern := range []string{"*.go", "input*.txt"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range matches {
+			info, err := os.Stat(path)
+			if err != nil {
+				continue // removed since the glob
+			}
+			files[filepath.Base(path)] = stamp{info.ModTime().UnixNano(), info.Size()}
+		}
+	}
+	return files, nil
+}
+
+// changedFiles lists the files added, changed or removed between two polls
+// in name order. Everything has changed on the first poll.
+func changedFiles(old, cur map[string]stamp) []string {
+	if old == nil {
+		return []string{"start"}
+	}
+	var changed []string
+	for name, s := range cur {
+		if prev, ok := old[name]; !ok || prev != s {
+			changed = append(changed, name)
+		}
+	}
+	for name := range old {
+		if _, ok := cur[name]; !ok {
+			changed = append(changed, name+" (removed)")
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// moduleRoot returns the directory holding the go.mod above dir.
+func moduleRoot(dir string) (string, error) {
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			return d, nil
+		}
+		if filepath.Dir(d) == d {
+			return "", fmt.Errorf("no go.mod above %s; watch needs the source tree", dir)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestChangedFiles(t *testing.T) {
+	a, b := stamp{mod: 1, size: 10}, stamp{mod: 2, size: 10}
+	tests := []struct {
+		name     string
+		old, cur map[string]stamp
+		want     []string
+	}{
+		{"first poll", nil, map[string]stamp{"solution.go": a}, []string{"start"}},
+		{"first poll of an empty directory", nil, map[string]stamp{}, []string{"start"}},
+		{"nothing changed", map[string]stamp{"solution.go": a}, map[string]stamp{"solution.go": a}, nil},
+		{"modified", map[string]stamp{"solution.go": a}, map[string]stamp{"solution.go": b}, []string{"solution.go"}},
+		{"resized", map[string]stamp{"input.txt": a}, map[string]stamp{"input.txt": {mod: 1, size: 11}}, []string{"input.txt"}},
+		{"added", map[string]stamp{}, map[string]stamp{"input2.txt": a}, []string{"input2.txt"}},
+		{"removed", map[string]stamp{"input2.txt": a}, map[string]stamp{}, []string{"input2.txt (removed)"}},
+		{
+			"several, in name order",
+			map[string]stamp{"solution.go": a, "input.txt": a, "generate.go": a},
+			map[string]stamp{"solution.go": b, "input.txt": a, "input3.txt": a},
+			[]string{"generate.go (removed)", "input3.txt", "solution.go"},
+		},
+	}
+	for _, tt := range tests {
+		if got := changedFiles(tt.old, tt.cur); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestAnswerChange(t *testing.T) {
+	last := map[int]string{1: "42"}
+	tests := []struct {
+		last   map[int]string
+		part   int
+		answer string
+		want   string
+	}{
+		{nil, 1, "42", ""},
+		{last, 1, "42", " (unchanged)"},
+		{last, 1, "43", " (was 42)"},
+		{last, 2, "7", " (new)"},
+		{map[int]string{}, 1, "42", " (new)"},
+	}
+	for _, tt := range tests {
+		if got := answerChange(tt.last, tt.part, tt.answer); got != tt.want {
+			t.Errorf("answerChange(%v, %d, %q) = %q, want %q", tt.last, tt.part, tt.answer, got, tt.want)
+		}
+	}
+}
+
+func TestStamps(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range []string{"solution.go", "input.txt", "input2.txt", "answers.json", "note", "input.go.bak"} {
+		write(name, "x")
+	}
+	if err := os.Mkdir(filepath.Join(dir, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	write(filepath.Join("sub", "other.go"), "x")
+
+	first, err := stamps(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for name := range first {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	if want := []string{"input.txt", "input2.txt", "solution.go"}; !slices.Equal(names, want) {
+		t.Fatalf("stamps = %q, want %q", names, want)
+	}
+
+	// A rewrite of the same size is told apart by its modification time.
+	write("solution.go", "y")
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "solution.go"), later, later); err != nil {
+		t.Fatal(err)
+	}
+	write("input.txt", "xy")
+	if err := os.Remove(filepath.Join(dir, "input2.txt")); err != nil {
+		t.Fatal(err)
+	}
+	second, err := stamps(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"input.txt", "input2.txt (removed)", "solution.go"}
+	if got := changedFiles(first, second); !slices.Equal(got, want) {
+		t.Errorf("changedFiles = %q, want %q", got, want)
+	}
+
+	empty, err := stamps(filepath.Join(dir, "missing"))
+	if err != nil || len(empty) != 0 {
+		t.Errorf("stamps of a missing directory = %v, %v, want none", empty, err)
+	}
+}
@@ -0,0 +1,7 @@
+package main
+
+// Every year package imports its days, so importing a year is all the
+// runner needs. The new command adds a year here when it creates it.
+import (
+	_ "adventofcode/2025"
+)